@@ -2,14 +2,27 @@
 package gotests
 
 import (
+	"bytes"
 	"fmt"
+	"go/ast"
 	"go/importer"
+	"go/parser"
+	"go/token"
 	"go/types"
+	"io/ioutil"
+	"os"
 	"path"
+	"path/filepath"
 	"regexp"
+	"runtime"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"text/template"
+	"time"
 
+	"github.com/cweill/gotests/internal/fields"
 	"github.com/cweill/gotests/internal/goparser"
 	"github.com/cweill/gotests/internal/input"
 	"github.com/cweill/gotests/internal/models"
@@ -18,15 +31,265 @@ import (
 
 // Options provides custom filters and parameters for generating tests.
 type Options struct {
-	Only        *regexp.Regexp        // Includes only functions that match.
-	Exclude     *regexp.Regexp        // Excludes functions that match.
-	Exported    bool                  // Include only exported methods
-	PrintInputs bool                  // Print function parameters in error messages
-	Subtests    bool                  // Print tests using Go 1.7 subtests
-	AllowError  bool				  // Allow error
-	Importer    func() types.Importer // A custom importer.
+	Only           *regexp.Regexp // Includes only functions that match.
+	Exclude        *regexp.Regexp // Excludes functions that match.
+	OnlyReceiver   *regexp.Regexp // Includes only methods whose receiver type name matches.
+	ExclReceiver   *regexp.Regexp // Excludes methods whose receiver type name matches.
+	Exported       bool           // Include only exported methods
+	PrintInputs    bool           // Print function parameters in error messages
+	Subtests       bool           // Print tests using Go 1.7 subtests
+	AllowError     bool           // Allow error
+	Benchmarks     bool           // Generate benchmarks alongside tests
+	BenchSizes     []int          // Sizes of the int or slice parameter that each benchmark runs a sub-benchmark with
+	Fuzz           bool           // Generate Go 1.18 fuzz targets for eligible functions
+	CmpDiff        bool           // Compare results with cmp.Diff instead of the default assertions
+	Merge          bool           // Append to existing test files, leaving their code untouched
+	External       bool           // Generate blackbox tests in an external _test package
+	Parallel       bool           // Run subtests in parallel with t.Parallel
+	NoFillContext  bool           // Pass the context.Context parameters in the test cases, instead of context.Background()
+	MockInterfaces bool           // Pass mocks for single-method interface parameters
+	Cleanup        bool           // Close the first result of functions with t.Cleanup, if it has a Close() error method
+	Helpers        bool           // Set up struct receivers with fields in a setupTest helper calling t.Helper
+	// How the tests compare errors: "bool" (the default) checks for one,
+	// "is" with errors.Is, and "message" by their message.
+	ErrorComparison string
+	Assertion       string // How the tests assert results: "should" (the default) with a should := require.New(t), or "testify" with the require functions
+	CaseVarName     string // Name of the table of test cases. Defaults to "tests"
+	ArgsStructName  string // Name of the struct type of the arguments, and its field in the test cases. Defaults to "args"
+	Examples        bool   // Generate Example functions printing the results of exported functions. Only used with External
+	HTTPHandlers    bool   // Test functions with the signature of an http.HandlerFunc with httptest
+	CopyDoc         bool   // Copy the doc comments of the functions to their tests
+	VariadicCases   bool   // Seed the test cases of variadic functions with none and two variadic arguments
+	SplitFiles      bool   // Generate the test of each function into a file of its own, such as foo_bar_test.go for Bar in foo.go
+	TemplateDir     string // Directory of .tmpl files overriding the built-in templates
+	// Construct the channel and function arguments that the test cases
+	// leave nil, with make and stubs returning zero values. The tests of
+	// functions with a variadic parameter of functional options, such as
+	// ...Option, are seeded with a case of no options, whose comment lists
+	// the With functions of the package returning them.
+	ScaffoldComplexArgs bool
+	// Add wantPanic and wantPanicMsg fields to the test cases, and recover
+	// from the panics of the calls to check them.
+	Panics bool
+	// Replace the existing test files of the source files, regenerating the
+	// tests they have, instead of adding to them. The functions tested in
+	// the other test files of the package are still skipped. Not used with
+	// Merge or SplitFiles.
+	Overwrite bool
+	// The container of the test cases: "slice" (the default) of structs
+	// with a name field, or "map" keyed by the case names. Maps are ranged
+	// over in random order, but t.Run names are unique either way.
+	TableStyle string
+	// Resolve the types of the imports with golang.org/x/tools/go/packages,
+	// as the go command does, such as those of other packages of the module.
+	// Falls back to Importer if the package can't be loaded. Not used by
+	// GenerateTestsFromSource.
+	LoadPackages bool
+	// Compare the string and []byte results of functions against golden
+	// files under testdata, which the tests rewrite when run with -update.
+	Golden bool
+	// The verb formatting the results and wanted values in the messages of
+	// failed comparisons, without the %: "v" (the default), "+v" to show
+	// the field names of structs, or "#v" for Go syntax.
+	MessageFormat string
+	// Skip the functions whose signature has unexported types, which
+	// External tests can't reference, instead of generating tests calling
+	// them with the arguments they can construct, such as with the exported
+	// functions returning them.
+	SkipUnexposable bool
+	// Set the environment variables that functions read with os.Getenv or
+	// os.LookupEnv to empty placeholders with t.Setenv in each test case.
+	// Not used with Parallel, since t.Setenv can't be used in parallel tests.
+	EnvSetup bool
+	// Sort the slice results and wanted values of ordered elements before
+	// comparing them, so that their order doesn't matter, with
+	// cmpopts.SortSlices given CmpDiff.
+	SortSlices bool
+	// Template of the names of the tests, executed with the Receiver type
+	// name, empty for functions, the Name, and the Package of each function,
+	// such as Test_{{.Package}}_{{.Name}}. Defaults to TestFunc for
+	// functions and TestType_Method for methods.
+	TestNameTemplate *template.Template
+	// Skip the functions without results, io.Writer parameters, or an
+	// error, whose tests would have nothing to assert, unless Only matches
+	// them.
+	SkipNoResult bool
+	// Generate the tests in the package under test, or in the external
+	// _test package, whatever package most of its existing tests are in.
+	// GenerateTests otherwise conforms to those tests, so that the package
+	// clauses of the tests don't mix, and External only decides for the
+	// packages without tests or with as many tests in both.
+	ForceInternal bool
+	ForceExternal bool
+	// Generate tests for the source files marked as generated by a
+	// "// Code generated ... DO NOT EDIT." comment too, which GenerateTests
+	// skips by default, such as protobuf and mock code.
+	IncludeGenerated bool
+	// Fail the subtests whose call takes longer than CaseTimeout, which
+	// they make in a goroutine guarded by a context.WithTimeout. Not used
+	// without Subtests, or with Panics since the panics of the calls in
+	// goroutines can't be recovered.
+	CaseTimeout time.Duration
+	// Name the subtests after the indexes of their test cases, as case_0,
+	// case_1, and so on, instead of a name field of the test cases. Not used
+	// without Subtests, or with the "map" TableStyle, whose keys name them.
+	NumberCases bool
+	// Compare the values that the pointer results and wanted values point
+	// to, once checked to be both nil or both non-nil, so that the messages
+	// of failed comparisons show the values rather than their addresses.
+	DerefPointers bool
+	// Capture the output of the functions printing to standard output, with
+	// the fmt.Print functions or os.Stdout, by redirecting os.Stdout to a
+	// pipe during their call, and compare it against a wantOutput field of
+	// the test cases. Not used with Parallel, since the parallel
+	// tests would share os.Stdout.
+	CaptureStdout bool
+	// Start the tables of test cases with Cases blank test cases, named
+	// case1, case2, and so on, to fill in, instead of a TODO comment.
+	Cases int
+	// Values available to the templates as .TemplateParams. Keys that
+	// aren't set render as empty.
+	TemplateParams map[string]interface{}
+	// Leave the imports as rendered, instead of adding the missing ones and
+	// removing the unused ones as goimports does.
+	NoFixImports bool
+	Importer     func() types.Importer // A custom importer.
+	// Functions available to the templates, such as the custom ones of
+	// TemplateDir, in addition to those of text/template and the built-in
+	// helpers: Field, Receiver, Param, Want, and Got return the names of the
+	// identifiers declared for a field, Seed, Zero, Samples, and Scaffold
+	// expressions of its values, Comment a doc comment, and Duration the
+	// expression of a time.Duration. The names of the built-in helpers are
+	// taken.
+	TemplateFuncs template.FuncMap
+	// Comment rendered verbatim at the top of new test files, such as a
+	// license header, unless they already have it.
+	HeaderComment string
+	// Seed the tables of test cases with the boundary values of the integer
+	// and floating-point parameters: 0, their minimum and maximum, -1, and
+	// 1, as many of their combinations as fit in 8 test cases.
+	BoundaryCases bool
+	// Receive from the channels that functions return, failing the tests
+	// after a second without a value, and compare the value received
+	// against the wanted one, instead of comparing the channels.
+	AsyncPattern bool
+	// Build the receivers of methods with the New function of their type,
+	// such as NewFoo for Foo or *Foo, if the package declares one returning
+	// only the type or a pointer to it, from the arguments of a setup field
+	// of the test cases, rather than from a zero value or its fields.
+	UseConstructors bool
+	// Add a TestMain function, with the setup and teardown of the tests left
+	// to do, to the first of the generated tests of each package, unless
+	// its test files already declare one.
+	GenerateTestMain bool
+	// Check the trailing bool result of the functions without an error,
+	// such as ok in (value int, ok bool), as the success of the call
+	// against a wantOk field of the test cases, as an error would be.
+	BoolAsError bool
+	// Check that the tests leave no goroutines running with goleak, deferring
+	// goleak.VerifyNone(t) at the start of each test, or, with LeakCheckMain,
+	// verifying the whole package once in its TestMain.
+	LeakCheck bool
+	// With LeakCheck, verify the package with goleak.VerifyTestMain in the
+	// TestMain function added as GenerateTestMain does, after all of its
+	// tests have run, instead of in each test.
+	LeakCheckMain bool
+	// Break the struct literals of the test cases that have several fields,
+	// such as the arguments of seeded cases, onto one line per field, as
+	// hand-written tables of test cases have them.
+	MultilineCases bool
+	// Compare the results whose type has an Equal(T) bool method, such as
+	// time.Time, with it, as in if !got.Equal(tt.want), rather than field by
+	// field.
+	UseEqualMethod bool
+	// Comment the tests with the conditions of the if, switch, and for
+	// statements of the functions they test, such as if x > 0, as a
+	// checklist of the cases to add.
+	CoverageHints bool
+	// Import path of a package wrapping testing, such as
+	// example.com/xtesting, whose T type the tests take instead of
+	// *testing.T, and call Run, Errorf, and Fatal on. Defaults to testing.
+	TestingPkg string
+	// Generate tests that pass strict linters: the subtests copy the test
+	// case with tt := tt, even when they don't run in parallel, and the test
+	// functions are annotated with //nolint:paralleltest, or with
+	// //nolint:tparallel if only their subtests run in parallel.
+	LintFriendly bool
+	// Skip the tests whose table has no generated test cases with
+	// t.Skip("TODO: implement"), so that they show up as skipped rather than
+	// passing until their cases are filled in.
+	SkipEmpty bool
+	// Add a concurrent subtest to each test case, which calls the function
+	// with its arguments from several goroutines at once without checking the
+	// results, for functions meant to be safe for concurrent use. It's only
+	// meaningful with go test -race, which reports the data races of the
+	// calls. Ignored with Panics, since the panics of the calls in goroutines
+	// can't be recovered.
+	ConcurrencyCase bool
+	// Name the subtests after the arguments of their test cases, formatted
+	// with %v and with their spaces and slashes replaced by underscores,
+	// instead of a name field of the test cases. Not used without Subtests,
+	// with the "map" TableStyle, whose keys name them, or for the functions
+	// without parameters.
+	AutoName bool
+	// Compare the struct results field by field, so that the messages of
+	// failed comparisons name the fields that differ. With CmpDiff, the
+	// fields that can be compared with == are, and the others with cmp.Diff.
+	FieldDiff bool
+	// Version of Go, such as 1.22, that the tests are generated for, which
+	// decides the idioms they use: before Go 1.22, whose for loops declare
+	// their variables once rather than per iteration, the parallel subtests,
+	// and with LintFriendly the others, copy the test case with tt := tt.
+	// Defaults to the version of the Go toolchain.
+	GoVersion string
+	// Set the parameters that look like paths up in a t.TempDir of each test
+	// case that leaves them unset: the string parameters named dir, or ending
+	// in Dir, with the directory, those named path or file, or ending in Path
+	// or File, with a path in it, and the *os.File parameters with a file
+	// created in it.
+	TempDirForPaths bool
+	// Group the tests of the functions in a section at the top, and those of
+	// the methods of each receiver type in a section after them, each
+	// starting with a comment such as // Tests for type Foo.
+	GroupByReceiver bool
+	// Seed the tests of the functions returning an error with a "success"
+	// test case, and an "error" one wanting the error, to stub its path.
+	SeedErrorCase bool
+	// Select only the function whose declaration, doc comment included,
+	// spans the 1-based Line, or the byte Offset, of the source file, such
+	// as the one under the cursor of an editor. GenerateTests returns an
+	// error if no function does, or if its source path is a directory.
+	Line   int
+	Offset int
+	// Build tags, such as integration, that new test files are constrained
+	// to, in addition to the build constraint of their source, with a
+	// //go:build line and the matching // +build lines.
+	BuildTags []string
+	// Called with the test file path for each function that gets no new
+	// test, and why. It may be called concurrently for the files of a
+	// directory.
+	Skipped func(testPath string, f *models.Function, reason SkipReason)
+	// Called with the path of each generated source file skipped, unless
+	// IncludeGenerated is set.
+	SkippedGenerated func(srcPath string)
+	// Called with the syntax tree of each generated test file, its imports
+	// resolved, to modify before it's formatted, such as to rewrite its
+	// assertions. An error aborts the generation of the file.
+	Transform func(*ast.File) error
 }
 
+// A SkipReason tells why a function gets no new test.
+type SkipReason string
+
+const (
+	Tested      SkipReason = "already tested"        // A test file of the package has its test.
+	FilteredOut SkipReason = "filtered out"          // Excluded by the options.
+	Unsupported SkipReason = "unsupported signature" // Such as an init without parameters or results.
+	// Unexported types in the signature, which the external test package
+	// can't reference. Only with SkipUnexposable.
+	Unexposable SkipReason = "unexported types in its signature"
+)
+
 // A GeneratedTest contains information about a test file with generated tests.
 type GeneratedTest struct {
 	Path      string             // The test file's absolute path.
@@ -38,8 +301,9 @@ type GeneratedTest struct {
 // signatures defined in the target source path file(s). The source path
 // parameter can be either a Go source file or directory containing Go files.
 func GenerateTests(srcPath string, opt *Options) ([]*GeneratedTest, error) {
-	if opt == nil {
-		opt = &Options{}
+	opt = defaultOptions(opt)
+	if fi, err := os.Stat(srcPath); err == nil && fi.IsDir() && (opt.Line > 0 || opt.Offset > 0) {
+		return nil, fmt.Errorf("Line and Offset select a function of a source file, not of the directory %v", srcPath)
 	}
 	srcFiles, err := input.Files(srcPath)
 	if err != nil {
@@ -49,15 +313,134 @@ func GenerateTests(srcPath string, opt *Options) ([]*GeneratedTest, error) {
 	if err != nil {
 		return nil, fmt.Errorf("input.Files: %v", err)
 	}
-	if opt.Importer == nil || opt.Importer() == nil {
-		opt.Importer = importer.Default
+	if !opt.IncludeGenerated {
+		srcFiles = nonGenerated(srcFiles, opt.SkippedGenerated)
+	}
+	if opt.LoadPackages && len(srcFiles) > 0 {
+		// Without the go command, the imports are parsed as usual.
+		if imp, err := goparser.PackageImporter(path.Dir(string(srcFiles[0])), opt.Importer); err == nil {
+			opt.Importer = imp
+		}
+	}
+	if len(srcFiles) > 0 {
+		opt.External = externalTests(path.Dir(string(srcFiles[0])), opt)
+	}
+	gts, err := parallelize(srcFiles, files, opt)
+	if err == nil && (opt.GenerateTestMain || opt.LeakCheck && opt.LeakCheckMain) {
+		gts, err = declareTestMains(gts, true, opt)
+	}
+	if err != nil || !opt.Golden {
+		return gts, err
+	}
+	return declareUpdateFlags(gts, true, opt)
+}
+
+// GenerateTestsFromSource generates table-driven tests for the function and
+// method signatures defined in the Go source src, without reading from or
+// writing to the filesystem. The filename is the path the source would have
+// on disk, from which the returned test's Path is derived. Since no other
+// files of the package are parsed, only the types declared in src and its
+// imports are resolved.
+func GenerateTestsFromSource(filename string, src []byte, opt *Options) ([]*GeneratedTest, error) {
+	opt = defaultOptions(opt)
+	opt.External = opt.ForceExternal || opt.External && !opt.ForceInternal
+	p := &goparser.Parser{Importer: opt.Importer(), External: opt.External}
+	sr, err := p.ParseSource(filename, src, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Parser.ParseSource: %v", err)
+	}
+	if err := checkPosition(filename, sr.Funcs, opt); err != nil {
+		return nil, err
+	}
+	if err := nameTests(sr.Funcs, sr.Header.Package, opt.TestNameTemplate); err != nil {
+		return nil, err
+	}
+	h := sr.Header
+	h.Code = nil
+	if opt.External {
+		externalHeader(h, path.Dir(filename))
 	}
-	return parallelize(srcFiles, files, opt)
+	testPath := models.Path(filename).TestPath()
+	var gts []*GeneratedTest
+	if opt.SplitFiles {
+		gts, err = splitTests(nil, testPath, h, sr.Funcs, nil, opt)
+	} else {
+		gts, err = tests(renderTest(testPath, h, sr.Funcs, nil, opt))
+	}
+	if err == nil && (opt.GenerateTestMain || opt.LeakCheck && opt.LeakCheckMain) {
+		gts, err = declareTestMains(gts, false, opt)
+	}
+	if err != nil || !opt.Golden {
+		return gts, err
+	}
+	return declareUpdateFlags(gts, false, opt)
+}
+
+// defaultOptions returns a copy of opt with the defaults filled in, so that
+// concurrent callers may share opt.
+func defaultOptions(opt *Options) *Options {
+	if opt == nil {
+		opt = &Options{}
+	}
+	o := *opt
+	if o.Importer == nil || o.Importer() == nil {
+		o.Importer = importer.Default
+	}
+	return &o
+}
+
+// nonGenerated returns the srcFiles that aren't generated, calling skip, if
+// it's not nil, with the others.
+func nonGenerated(srcFiles []models.Path, skip func(srcPath string)) []models.Path {
+	var fs []models.Path
+	for _, src := range srcFiles {
+		if !input.IsGenerated(string(src)) {
+			fs = append(fs, src)
+		} else if skip != nil {
+			skip(string(src))
+		}
+	}
+	return fs
+}
+
+// externalTests reports whether to generate the tests of the package in dir
+// in an external _test package: in that of most of its existing test files,
+// unless opt forces one. The test files that can't be parsed are ignored.
+func externalTests(dir string, opt *Options) bool {
+	if opt.ForceInternal || opt.ForceExternal {
+		return opt.ForceExternal
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*_test.go"))
+	if err != nil {
+		return opt.External
+	}
+	var internal, external int
+	for _, p := range paths {
+		if strings.HasPrefix(filepath.Base(p), ".") {
+			continue
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), p, nil, parser.PackageClauseOnly)
+		if err != nil {
+			continue
+		}
+		if strings.HasSuffix(f.Name.Name, "_test") {
+			external++
+		} else {
+			internal++
+		}
+	}
+	switch {
+	case internal > external:
+		return false
+	case external > internal:
+		return true
+	}
+	return opt.External
 }
 
 // result stores a generateTest result.
 type result struct {
-	gt  *GeneratedTest
+	gts []*GeneratedTest
 	err error
 }
 
@@ -71,7 +454,7 @@ func parallelize(srcFiles, files []models.Path, opt *Options) ([]*GeneratedTest,
 		go func(src models.Path) {
 			defer wg.Done()
 			r := &result{}
-			r.gt, r.err = generateTest(src, files, opt)
+			r.gts, r.err = generateTest(src, files, opt)
 			rs <- r
 		}(src)
 	}
@@ -83,42 +466,233 @@ func parallelize(srcFiles, files []models.Path, opt *Options) ([]*GeneratedTest,
 	return readResults(rs)
 }
 
-// readResults reads the result channel.
+// readResults reads the result channel, returning the tests sorted by path
+// whatever order they complete in.
 func readResults(rs <-chan *result) ([]*GeneratedTest, error) {
 	var gts []*GeneratedTest
 	for r := range rs {
 		if r.err != nil {
 			return nil, r.err
 		}
-		if r.gt != nil {
-			gts = append(gts, r.gt)
-		}
+		gts = append(gts, r.gts...)
 	}
+	sort.Slice(gts, func(i, j int) bool {
+		return gts[i].Path < gts[j].Path
+	})
 	return gts, nil
 }
 
-func generateTest(src models.Path, files []models.Path, opt *Options) (*GeneratedTest, error) {
-	p := &goparser.Parser{Importer: opt.Importer()}
+func generateTest(src models.Path, files []models.Path, opt *Options) ([]*GeneratedTest, error) {
+	p := &goparser.Parser{Importer: opt.Importer(), External: opt.External}
 	sr, err := p.Parse(string(src), files)
 	if err != nil {
 		return nil, fmt.Errorf("Parser.Parse source file: %v", err)
 	}
+	if err := checkPosition(string(src), sr.Funcs, opt); err != nil {
+		return nil, err
+	}
+	if err := nameTests(sr.Funcs, sr.Header.Package, opt.TestNameTemplate); err != nil {
+		return nil, err
+	}
 	h := sr.Header
 	h.Code = nil // Code is only needed from parsed test files.
+	if opt.External {
+		externalHeader(h, path.Dir(string(src)))
+	}
 	testPath := models.Path(src).TestPath()
-	h, tf, err := parseTestFile(p, testPath, h)
+	sib, err := siblingTestFuncs(testPath)
 	if err != nil {
 		return nil, err
 	}
-	funcs := testableFuncs(sr.Funcs, opt.Only, opt.Exclude, opt.Exported, tf)
+	if opt.SplitFiles {
+		return splitTests(p, testPath, h, sr.Funcs, sib, opt)
+	}
+	if opt.Merge {
+		return tests(mergeTest(p, testPath, h, sr.Funcs, sib, opt))
+	}
+	if opt.Overwrite {
+		return tests(renderTest(testPath, h, sr.Funcs, sib, opt))
+	}
+	h, tf, err := parseTestFile(p, testPath, h, opt.External)
+	if err != nil {
+		return nil, err
+	}
+	return tests(renderTest(testPath, h, sr.Funcs, append(tf, sib...), opt))
+}
+
+// checkPosition returns an error if opt selects a function by Line or Offset
+// and none of funcs, declared in the source file at path, spans them.
+func checkPosition(path string, funcs []*models.Function, opt *Options) error {
+	if opt.Line <= 0 && opt.Offset <= 0 {
+		return nil
+	}
+	for _, f := range funcs {
+		if isAt(f, opt.Line, opt.Offset) {
+			return nil
+		}
+	}
+	if opt.Line > 0 {
+		return fmt.Errorf("no function is declared at line %v of %v", opt.Line, path)
+	}
+	return fmt.Errorf("no function is declared at offset %v of %v", opt.Offset, path)
+}
+
+// nameTests sets the CustomTestName of funcs, of package pkg, to the names
+// that tmpl executes to, unless tmpl is nil. The names must be those of test
+// functions, and tell the tests of funcs apart.
+func nameTests(funcs []*models.Function, pkg string, tmpl *template.Template) error {
+	if tmpl == nil {
+		return nil
+	}
+	named := make(map[string]*models.Function)
+	for _, f := range funcs {
+		var recv string
+		if f.Receiver != nil {
+			recv = f.Receiver.Type.TypeName()
+		}
+		b := &bytes.Buffer{}
+		if err := tmpl.Execute(b, struct{ Receiver, Name, Package string }{recv, f.Name, pkg}); err != nil {
+			return fmt.Errorf("-testname template: %v", err)
+		}
+		name := b.String()
+		if !token.IsIdentifier(name) || !strings.HasPrefix(name, "Test") {
+			return fmt.Errorf("-testname template: %q is not the name of a test function", name)
+		}
+		if g, ok := named[name]; ok {
+			return fmt.Errorf("-testname template: the tests of %v and %v would both be named %v", funcName(g), funcName(f), name)
+		}
+		named[name] = f
+		f.CustomTestName = name
+	}
+	return nil
+}
+
+// funcName returns the name of f, qualified with its receiver type's name
+// for methods.
+func funcName(f *models.Function) string {
+	if f.Receiver != nil {
+		return f.Receiver.Type.TypeName() + "." + f.Name
+	}
+	return f.Name
+}
+
+// tests returns the slice of gt, if any, or err.
+func tests(gt *GeneratedTest, err error) ([]*GeneratedTest, error) {
+	if err != nil || gt == nil {
+		return nil, err
+	}
+	return []*GeneratedTest{gt}, nil
+}
+
+// splitTests generates the test of each of the testable funcs into a file
+// of its own, named after testPath and the function, such as foo_bar_test.go.
+// The functions tested in the file at testPath, or in sib, are skipped.
+// Unless p is nil, the existing files are read as by generateTest.
+func splitTests(p *goparser.Parser, testPath string, h *models.Header, funcs []*models.Function, sib []string, opt *Options) ([]*GeneratedTest, error) {
+	var tf []string
+	if p != nil {
+		var err error
+		if _, tf, err = parseTestFile(p, testPath, h, opt.External); err != nil {
+			return nil, err
+		}
+	}
+	funcs = testableFuncs(funcs, opt, append(tf, sib...), skipper(opt, testPath))
+	var gts []*GeneratedTest
+	for i, sp := range splitPaths(testPath, funcs) {
+		fs := []*models.Function{funcs[i]}
+		var gt *GeneratedTest
+		var err error
+		switch {
+		case p != nil && opt.Merge:
+			gt, err = mergeTest(p, sp, h, fs, nil, opt)
+		case p != nil:
+			sh, stf, perr := parseTestFile(p, sp, h, opt.External)
+			if perr != nil {
+				return nil, perr
+			}
+			gt, err = renderTest(sp, sh, fs, stf, opt)
+		default:
+			gt, err = renderTest(sp, h, fs, nil, opt)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if gt != nil {
+			gts = append(gts, gt)
+		}
+	}
+	return gts, nil
+}
+
+// splitPaths returns the paths of the test files of funcs split from the
+// one at testPath. They're lower case, so the paths of functions whose names
+// differ only by case are told apart by a numeric suffix, for case-insensitive
+// filesystems.
+func splitPaths(testPath string, funcs []*models.Function) []string {
+	base := strings.TrimSuffix(testPath, "_test.go")
+	seen := make(map[string]bool)
+	var paths []string
+	for _, f := range funcs {
+		name := f.Name
+		if f.Receiver != nil {
+			name = f.Receiver.Type.TypeName() + "_" + name
+		}
+		p := base + "_" + strings.ToLower(name)
+		for i := 2; seen[p]; i++ {
+			p = fmt.Sprintf("%v_%v_%v", base, strings.ToLower(name), i)
+		}
+		seen[p] = true
+		paths = append(paths, p+"_test.go")
+	}
+	return paths
+}
+
+// mergeTest appends the tests for funcs without one, in the file or in sib,
+// to the existing test file at testPath, or renders a new test file if there
+// isn't one.
+func mergeTest(p *goparser.Parser, testPath string, h *models.Header, funcs []*models.Function, sib []string, opt *Options) (*GeneratedTest, error) {
+	if !output.IsFileExist(testPath) {
+		return renderTest(testPath, h, funcs, sib, opt)
+	}
+	b, err := ioutil.ReadFile(testPath)
+	if err != nil {
+		return nil, fmt.Errorf("ioutil.ReadFile: %v", err)
+	}
+	tr, err := p.ParseSource(testPath, b, nil)
+	if err != nil {
+		if err == goparser.ErrEmptyFile {
+			// Overwrite empty test files.
+			return renderTest(testPath, h, funcs, sib, opt)
+		}
+		return nil, fmt.Errorf("Parser.ParseSource test file: %v", err)
+	}
+	if opt.External && tr.Header.Package != h.Package {
+		return nil, fmt.Errorf("test file %v is not in package %v", testPath, h.Package)
+	}
+	tf := append(funcNames(tr.Funcs), sib...)
+	funcs = testableFuncs(funcs, opt, tf, skipper(opt, testPath))
 	if len(funcs) == 0 {
 		return nil, nil
 	}
-	b, err := output.Process(h, funcs, &output.Options{
-		PrintInputs: opt.PrintInputs,
-		Subtests:    opt.Subtests,
-		AllowError:  opt.AllowError,
-	})
+	out, err := output.Merge(b, h, funcs, outputOptions(opt, tf))
+	if err != nil {
+		return nil, fmt.Errorf("output.Merge: %v", err)
+	}
+	return &GeneratedTest{
+		Path:      testPath,
+		Functions: funcs,
+		Output:    out,
+	}, nil
+}
+
+// renderTest renders the tests for the testable funcs into a test file at
+// testPath, skipping the functions that already have one of testFuncs.
+func renderTest(testPath string, h *models.Header, funcs []*models.Function, testFuncs []string, opt *Options) (*GeneratedTest, error) {
+	funcs = testableFuncs(funcs, opt, testFuncs, skipper(opt, testPath))
+	if len(funcs) == 0 {
+		return nil, nil
+	}
+	b, err := output.Process(h, funcs, outputOptions(opt, testFuncs))
 	if err != nil {
 		return nil, fmt.Errorf("output.Process: %v", err)
 	}
@@ -129,7 +703,47 @@ func generateTest(src models.Path, files []models.Path, opt *Options) (*Generate
 	}, nil
 }
 
-func parseTestFile(p *goparser.Parser, testPath string, h *models.Header) (*models.Header, []string, error) {
+// goVersion returns the GoVersion of opt, or the version of the Go toolchain
+// if it isn't set.
+func goVersion(opt *Options) string {
+	if opt.GoVersion != "" {
+		return opt.GoVersion
+	}
+	return runtime.Version()
+}
+
+// outputOptions returns the output options of opt, which are those of the
+// same names, apart from the ones set here.
+func outputOptions(opt *Options, testFuncs []string) *output.Options {
+	o := &output.Options{}
+	fields.Copy(o, opt)
+	o.FixImports = !opt.NoFixImports
+	o.FillContext = !opt.NoFillContext
+	o.ScaffoldArgs = opt.ScaffoldComplexArgs
+	o.GoVersion = goVersion(opt)
+	o.Examples = opt.Examples && opt.External
+	o.TestFuncs = testFuncs
+	return o
+}
+
+// externalHeader turns h into the header of an external test package for the
+// package in dir, which imports the package under test.
+func externalHeader(h *models.Header, dir string) {
+	pkg := h.Package
+	h.Package += "_test"
+	ip, err := input.ImportPath(dir)
+	if err != nil {
+		// Leave it to goimports to resolve the package.
+		return
+	}
+	imp := &models.Import{Path: strconv.Quote(ip)}
+	if path.Base(ip) != pkg {
+		imp.Name = pkg
+	}
+	h.Imports = append(h.Imports, imp)
+}
+
+func parseTestFile(p *goparser.Parser, testPath string, h *models.Header, external bool) (*models.Header, []string, error) {
 	if !output.IsFileExist(testPath) {
 		return h, nil, nil
 	}
@@ -141,24 +755,201 @@ func parseTestFile(p *goparser.Parser, testPath string, h *models.Header) (*mode
 		}
 		return nil, nil, fmt.Errorf("Parser.Parse test file: %v", err)
 	}
-	var testFuncs []string
-	for _, fun := range tr.Funcs {
-		testFuncs = append(testFuncs, fun.Name)
+	if external && tr.Header.Package != h.Package {
+		return nil, nil, fmt.Errorf("test file %v is not in package %v", testPath, h.Package)
+	}
+	for _, imp := range tr.Header.Imports {
+		// The existing tests refer to them by name.
+		imp.Fixed = true
 	}
 	tr.Header.Imports = append(tr.Header.Imports, h.Imports...)
+	tr.Header.Existing = true
 	h = tr.Header
-	return h, testFuncs, nil
+	return h, funcNames(tr.Funcs), nil
 }
 
-func testableFuncs(funcs []*models.Function, only, excl *regexp.Regexp, exp bool, testFuncs []string) []*models.Function {
+// declareUpdateFlags declares the -update flag of the golden files in the
+// first of gts in each directory that has golden tests, since a flag can
+// only be defined once per test binary. Directories whose test files
+// already declare it, on disk too if disk is set, are left as is.
+func declareUpdateFlags(gts []*GeneratedTest, disk bool, opt *Options) ([]*GeneratedTest, error) {
+	declared := make(map[string]bool)
+	owners := make(map[string]*GeneratedTest)
+	var dirs []string
+	for _, gt := range gts {
+		dir := filepath.Dir(gt.Path)
+		if _, ok := declared[dir]; !ok {
+			declared[dir] = disk && declaresOnDisk(dir, gts, declaresUpdateFlag)
+			dirs = append(dirs, dir)
+		}
+		if declaresUpdateFlag(gt.Output) {
+			declared[dir] = true
+		}
+		if owners[dir] == nil && hasGoldenTests(gt.Functions) {
+			owners[dir] = gt
+		}
+	}
+	for _, dir := range dirs {
+		gt := owners[dir]
+		if gt == nil || declared[dir] {
+			continue
+		}
+		out, err := output.DeclareUpdateFlag(gt.Output, outputOptions(opt, nil))
+		if err != nil {
+			return nil, fmt.Errorf("output.DeclareUpdateFlag: %v", err)
+		}
+		gt.Output = out
+	}
+	return gts, nil
+}
+
+// declareTestMains adds a TestMain function to the first of gts in each
+// directory, since the tests of a package can only have one. Directories
+// whose test files already declare it, on disk too if disk is set, are left
+// as is.
+func declareTestMains(gts []*GeneratedTest, disk bool, opt *Options) ([]*GeneratedTest, error) {
+	declared := make(map[string]bool)
+	owners := make(map[string]*GeneratedTest)
+	var dirs []string
+	for _, gt := range gts {
+		dir := filepath.Dir(gt.Path)
+		if _, ok := declared[dir]; !ok {
+			declared[dir] = disk && declaresOnDisk(dir, gts, declaresTestMain)
+			dirs = append(dirs, dir)
+			owners[dir] = gt
+		}
+		if declaresTestMain(gt.Output) {
+			declared[dir] = true
+		}
+	}
+	for _, dir := range dirs {
+		if declared[dir] {
+			continue
+		}
+		gt := owners[dir]
+		out, err := output.DeclareTestMain(gt.Output, outputOptions(opt, nil))
+		if err != nil {
+			return nil, fmt.Errorf("output.DeclareTestMain: %v", err)
+		}
+		gt.Output = out
+	}
+	return gts, nil
+}
+
+// declaresOnDisk reports whether a test file in dir, other than those of gts,
+// declares what declares looks for.
+func declaresOnDisk(dir string, gts []*GeneratedTest, declares func(src []byte) bool) bool {
+	paths, _ := filepath.Glob(filepath.Join(dir, "*_test.go"))
+	generated := make(map[string]bool)
+	for _, gt := range gts {
+		generated[gt.Path] = true
+	}
+	for _, p := range paths {
+		if generated[p] {
+			continue
+		}
+		if b, err := ioutil.ReadFile(p); err == nil && declares(b) {
+			return true
+		}
+	}
+	return false
+}
+
+func declaresUpdateFlag(src []byte) bool {
+	return bytes.Contains(src, []byte(`flag.Bool("update"`))
+}
+
+func declaresTestMain(src []byte) bool {
+	return bytes.Contains(src, []byte("func TestMain("))
+}
+
+func hasGoldenTests(funcs []*models.Function) bool {
+	for _, fun := range funcs {
+		if fun.ReturnsText() {
+			return true
+		}
+	}
+	return false
+}
+
+// siblingTestFuncs returns the names of the functions declared in the test
+// files in the directory of testPath, other than testPath itself, whose
+// tests, benchmarks and examples would clash with generated ones.
+func siblingTestFuncs(testPath string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(filepath.Dir(testPath), "*_test.go"))
+	if err != nil {
+		return nil, fmt.Errorf("filepath.Glob: %v", err)
+	}
+	var names []string
+	for _, p := range paths {
+		if p == testPath {
+			continue
+		}
+		ns, err := goparser.FuncNames(p)
+		if err != nil {
+			return nil, fmt.Errorf("goparser.FuncNames: %v", err)
+		}
+		names = append(names, ns...)
+	}
+	return names, nil
+}
+
+// funcNames returns the sorted names of funcs.
+func funcNames(funcs []*models.Function) []string {
+	var names []string
+	for _, fun := range funcs {
+		names = append(names, fun.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// skipper returns the function reporting the functions skipped for the test
+// file at testPath to opt.Skipped, or nil if it isn't set.
+func skipper(opt *Options, testPath string) func(*models.Function, SkipReason) {
+	if opt.Skipped == nil {
+		return nil
+	}
+	return func(f *models.Function, reason SkipReason) {
+		opt.Skipped(testPath, f, reason)
+	}
+}
+
+// testableFuncs returns the funcs to generate tests for, in the order of
+// models.SortFunctions, and reports the others to skip. The filters of opt
+// compose: a function is generated for only if it matches Only, doesn't
+// match Exclude, is a method whose receiver type matches OnlyReceiver and not
+// ExclReceiver when they're set, spans the Line and Offset that are set,
+// and, with Exported, is exported. With SkipNoResult, it must also have something to assert,
+// unless Only matches it. With SkipUnexposable, the functions marked
+// Unexposable are skipped too.
+func testableFuncs(funcs []*models.Function, opt *Options, testFuncs []string, skip func(*models.Function, SkipReason)) []*models.Function {
 	sort.Strings(testFuncs)
 	var fs []*models.Function
 	for _, f := range funcs {
-		if isTestFunction(f, testFuncs) || isExcluded(f, excl) || isUnexported(f, exp) || !isIncluded(f, only) || isInvalid(f) {
+		var reason SkipReason
+		switch {
+		case isTestFunction(f, testFuncs):
+			reason = Tested
+		case isExcluded(f, opt.Exclude) || isUnexported(f, opt.Exported) || !isIncluded(f, opt.Only) || !isAt(f, opt.Line, opt.Offset):
+			reason = FilteredOut
+		case isExcludedReceiver(f, opt.ExclReceiver) || !isIncludedReceiver(f, opt.OnlyReceiver):
+			reason = FilteredOut
+		case opt.SkipNoResult && !hasAssertions(f) && opt.Only == nil:
+			reason = FilteredOut
+		case isInvalid(f):
+			reason = Unsupported
+		case opt.SkipUnexposable && f.Unexposable:
+			reason = Unexposable
+		default:
+			fs = append(fs, f)
 			continue
 		}
-		fs = append(fs, f)
+		if skip != nil {
+			skip(f, reason)
+		}
 	}
+	models.SortFunctions(fs)
 	return fs
 }
 
@@ -169,12 +960,18 @@ func isInvalid(f *models.Function) bool {
 	return false
 }
 
+// hasAssertions reports whether the tests of f have results to check: its
+// results, the output of its io.Writer parameters, or its error.
+func hasAssertions(f *models.Function) bool {
+	return len(f.TestResults()) > 0 || f.ReturnsError
+}
+
 func isTestFunction(f *models.Function, testFuncs []string) bool {
 	return len(testFuncs) > 0 && contains(testFuncs, f.TestName())
 }
 
 func isExcluded(f *models.Function, excl *regexp.Regexp) bool {
-	return excl != nil && (excl.MatchString(f.Name) || excl.MatchString(f.FullName()))
+	return excl != nil && matches(excl, f)
 }
 
 func isUnexported(f *models.Function, exp bool) bool {
@@ -182,7 +979,37 @@ func isUnexported(f *models.Function, exp bool) bool {
 }
 
 func isIncluded(f *models.Function, only *regexp.Regexp) bool {
-	return only == nil || only.MatchString(f.Name) || only.MatchString(f.FullName())
+	return only == nil || matches(only, f)
+}
+
+// isIncludedReceiver reports whether only is nil or matches the name of the
+// receiver type of f, so that it excludes the functions without one.
+func isIncludedReceiver(f *models.Function, only *regexp.Regexp) bool {
+	return only == nil || f.Receiver != nil && only.MatchString(f.Receiver.Type.TypeName())
+}
+
+// isExcludedReceiver reports whether excl matches the name of the receiver
+// type of f, which it never does for functions without one.
+func isExcludedReceiver(f *models.Function, excl *regexp.Regexp) bool {
+	return excl != nil && f.Receiver != nil && excl.MatchString(f.Receiver.Type.TypeName())
+}
+
+// isAt reports whether the declaration of f spans line and offset, when
+// they're set.
+func isAt(f *models.Function, line, offset int) bool {
+	if line > 0 && (line < f.Start.Line || line > f.End.Line) {
+		return false
+	}
+	return offset <= 0 || offset >= f.Start.Offset && offset <= f.End.Offset
+}
+
+// matches reports whether re matches f's name, its full name, or, for
+// methods, its Receiver.Method name.
+func matches(re *regexp.Regexp, f *models.Function) bool {
+	if re.MatchString(f.Name) || re.MatchString(f.FullName()) {
+		return true
+	}
+	return f.Receiver != nil && re.MatchString(f.Receiver.Type.TypeName()+"."+f.Name)
 }
 
 func contains(ss []string, s string) bool {
@@ -7,41 +7,487 @@
 //
 //   $ gotests [options] PATH ...
 //
+// Pass - as the PATH to read the source from stdin and print its tests.
+// Functions whose doc comment has a //gotests:skip directive never get tests.
+//
+// Options default to the values of a .gotests.yml or .gotests.json config file
+// in the current directory, or else in its closest parent that has one. Its
+// keys are the names of the options below, subtests for the inverse of
+// -nosubtests, or verbosity for -1 with -q and 1 with -v:
+//
+//   only: ^Get
+//   errcmp: is
+//   subtests: false
+//
+// Options set on the command line take precedence over the config file, which
+// takes precedence over the built-in defaults.
+//
 // Available options:
 //
-//   -all         generate tests for all functions and methods
+//   -all         generate tests for all functions and methods, narrowed by
+//                -exported, -only, and -excl
+//
+//   -allow       keep generating the tests of the other paths when one fails,
+//                and report all the failures at the end
+//
+//   -args-struct name of the struct type of the arguments, and its field in
+//                the test cases. Defaults to "args"
+//
+//   -assert      how to assert results: should with a should :=
+//                require.New(t), or testify with the require functions, such
+//                as require.Equal(t, tt.want, got). Defaults to should
+//
+//   -async       receive from the channels that functions return, failing the
+//                tests after a second without a value, and compare the value
+//                received against a want field of the element type
+//
+//   -autoname    name the subtests after the arguments of their test cases,
+//                formatted with %v, instead of a name field of the test cases.
+//                Not used with -nosubtests or -table map
+//
+//   -bench       generate benchmarks alongside tests
+//
+//   -bench-sizes comma-separated sizes, such as 10,100,1000, of the int or
+//                slice parameter that benchmarks run a sub-benchmark with.
+//                Requires -bench
+//
+//   -boolerr     check the trailing bool result of functions without an error,
+//                such as ok in (value int, ok bool), as the success of the
+//                call, against a wantOk field of the test cases
+//
+//   -boundary    seed the test cases with the boundary values of the integer
+//                and floating-point parameters: 0, their minimum and maximum,
+//                -1, and 1, as many of their combinations as fit in 8 test
+//                cases
+//
+//   -cache       directory of a cache by which the source files are skipped
+//                while they, the options, the templates and header files, the
+//                other files of their packages, and their test files are
+//                unchanged since their tests were last written. Requires -w
+//
+//   -case-timeout
+//                fail the subtests whose call takes longer than the timeout,
+//                such as 30s, which they make in a goroutine guarded by a
+//                context.WithTimeout. Not used with -nosubtests or -panics
+//
+//   -case-var    name of the table of test cases. Defaults to "tests"
+//
+//   -cases       number of blank test cases, named case1, case2, and so on,
+//                to start the tables of test cases with, instead of a TODO
+//                comment
+//
+//   -cleanup     close the first result of functions with t.Cleanup, if it
+//                has a Close() error method
+//
+//   -cmp         compare results with github.com/google/go-cmp/cmp.Diff
+//
+//   -concurrent  add a concurrent subtest to each test case, calling the
+//                function from several goroutines at once under a
+//                sync.WaitGroup. Only meaningful with go test -race, which
+//                reports the data races of the calls
+//
+//   -constructors
+//                build the receivers of methods with the New function of their
+//                type, such as NewFoo for Foo, if the package has one that
+//                returns only the type or a pointer to it, from the arguments
+//                of a setup field of the test cases, instead of a zero value
+//
+//   -copydoc     copy the doc comments of functions and methods to their tests
+//
+//   -deref       compare the values that pointer results and wanted values
+//                point to, once checked to be both nil or both non-nil, so
+//                that failed comparisons show the values rather than their
+//                addresses
+//
+//   -diff        print a unified diff against the existing test files instead
+//                of their output. Takes precedence over -w
+//
+//   -env         set the environment variables that functions read with
+//                os.Getenv or os.LookupEnv to placeholders with t.Setenv in
+//                each test case. Not used with -parallel
+//
+//   -equal       compare the results whose type has an Equal(T) bool method,
+//                such as time.Time, with it, as in !got.Equal(tt.want), instead
+//                of field by field
+//
+//   -errcmp      how to compare errors: bool checks for one, is with errors.Is
+//                against a wantErr error, and message against a wantErrMsg
+//                string. Defaults to bool
+//
+//   -examples    generate Example functions printing the results of exported
+//                functions. Requires -external or -force-external
 //
 //   -excl        regexp. generate tests for functions and methods that don't
-//                match. Takes precedence over -only, -exported, and -all
+//                match. Applies on top of -all and -exported, and excludes
+//                the functions that -only matches too
+//
+//   -excl-names  comma-separated names of functions and methods, as Func or
+//                Receiver.Method, to exclude in addition to -excl
+//
+//   -excl-recv   regexp. generate tests for the functions, and the methods
+//                whose receiver type name doesn't match. Applies on top of the
+//                other filters
+//
+//   -exported    generate tests for exported functions and methods only.
+//                Applies on top of -all, -only, and -excl
+//
+//   -external    generate blackbox tests in an external <pkg>_test package,
+//                unless most existing tests of the package are in the package
+//                itself. Skips unexported functions and methods
+//
+//   -fielddiff   compare the struct results field by field, so that failed
+//                comparisons name the fields that differ. With -cmp, the fields
+//                are compared with != when they can be, rather than with
+//                cmp.Diff
+//
+//   -fillcontext call functions with context.Background() for their
+//                context.Context parameters. Defaults to true
+//
+//   -fiximports  add missing and remove unused imports, as goimports does.
+//                Defaults to true
+//
+//   -force-external
+//                generate blackbox tests in an external <pkg>_test package,
+//                even if most existing tests of the package are in the package
+//                itself. Skips unexported functions and methods
 //
-//   -exported    generate tests for exported functions and methods. Takes
-//                precedence over -only and -all
+//   -force-internal
+//                generate tests in the package under test, even if most
+//                existing tests of the package are in its external _test
+//                package
+//
+//   -fuzz        generate Go 1.18 fuzz targets for functions with only
+//                primitive parameters
+//
+//   -go          version of Go, such as 1.22, that the tests are generated for,
+//                which decides the idioms they use, such as tt := tt in
+//                parallel subtests before Go 1.22. Defaults to the version of
+//                the Go toolchain
+//
+//   -golden      compare the string and []byte results of functions against
+//                golden files under testdata, which the tests rewrite when
+//                run with -update
+//
+//   -group-recv  group the tests of the functions, then those of the methods of
+//                each receiver type, in sections starting with a comment such
+//                as // Tests for type Foo
+//
+//   -header      comment at the top of new test files, such as a license
+//                header, or none. Defaults to "// Code generated by gotests.
+//                DO NOT EDIT."
+//
+//   -header-file file of the comment at the top of new test files. Takes
+//                precedence over -header
+//
+//   -helpers     set up struct receivers with fields in a setupTest helper
+//                calling t.Helper. Only affects methods on such receivers
+//
+//   -hints       comment the tests with a list of the conditions of the if,
+//                switch, and for statements of the functions they test, such as
+//                if x > 0, as a checklist of the test cases to add
+//
+//   -http        test functions with the signature of an http.HandlerFunc by
+//                calling them with an httptest request and recorder
 //
 //   -i           print test inputs in error messages
 //
+//   -include-generated
+//                generate tests for the source files marked as generated by a
+//                "// Code generated ... DO NOT EDIT." comment too, which are
+//                skipped by default
+//
+//   -json        print a JSON array of the generated tests, with their path,
+//                test names, and source
+//
+//   -leakcheck   defer goleak.VerifyNone(t) at the start of each test, failing
+//                it if goroutines it started are still running when it returns.
+//                Requires -leakcheck-main with -parallel
+//
+//   -leakcheck-main
+//                with -leakcheck, verify the whole package once with
+//                goleak.VerifyTestMain in a TestMain function, added as
+//                -testmain does, instead of in each test
+//
+//   -line        generate a test for only the function whose declaration, doc
+//                comment included, spans the line of the single source file,
+//                such as the one under the cursor of an editor
+//
+//   -lint        generate tests that pass strict linters: copy the test cases
+//                with tt := tt in the subtests, and annotate the tests with
+//                //nolint:paralleltest, or //nolint:tparallel with -parallel,
+//                since only their subtests run in parallel
+//
+//   -merge       append new tests to existing test files, leaving their code
+//                untouched
+//
+//   -mock        pass mocks for single-method interface parameters, declared
+//                in the test file
+//
+//   -msgfmt      the verb formatting the results and wanted values in the
+//                messages of failed comparisons: v (the default), +v to show
+//                the field names of structs, or #v for Go syntax
+//
+//   -multiline   break the struct literals of the test cases with several
+//                fields, such as the arguments of the cases seeded by
+//                -boundary, onto one line per field
+//
+//   -o           template of the test file paths, such as
+//                {{.Dir}}/tests/{{.Name}}_test.go, where Dir is the directory
+//                and Name the base name without .go of each source file.
+//                Defaults to <name>_test.go next to each source file
+//
+//   -offset      generate a test for only the function whose declaration, doc
+//                comment included, spans the byte offset of the single source
+//                file
+//
 //   -only        regexp. generate tests for functions and methods that match only.
-//                Takes precedence over -all
+//                Applies on top of -all and -exported
+//
+//   -only-names  comma-separated names of functions and methods, as Func or
+//                Receiver.Method, to generate tests for in addition to -only
+//
+//   -only-recv   regexp. generate tests for the methods whose receiver type
+//                name matches only, and none of the functions. Applies on top
+//                of the other filters
+//
+//   -paths-from  file listing the source files and directories to process after
+//                the arguments, one per line, or - to read them from stdin.
+//                Blank lines and lines starting with # are skipped. The missing
+//                paths fail the run, or are skipped with -allow
+//
+//   -relpaths    log the paths of the source and test files, such as those of
+//                unchanged files and failed writes, relative to the current
+//                directory, or to -relpaths-base, instead of as given or
+//                absolute
+//
+//   -relpaths-base
+//                with -relpaths, the directory that the logged paths are
+//                relative to, instead of the current directory
+//
+//   -seed-err    seed the tests of the functions returning an error with a
+//                "success" test case, and an "error" one wanting the error
+//
+//   -skip-empty  skip the tests without generated test cases with t.Skip, so
+//                that they don't pass until their cases are filled in
+//
+//   -tempdir     set the parameters that look like paths up in a t.TempDir of
+//                each test case that leaves them unset: string parameters named
+//                dir, or ending in Dir, with the directory, those named path or
+//                file, or ending in Path or File, with a path in it, and
+//                *os.File parameters with a file created in it
+//
+//   -testing-pkg import path of a package wrapping testing, such as
+//                example.com/xtesting, whose T type the tests take instead of
+//                *testing.T. Its T must have the methods of testing.T that the
+//                tests call
+//
+//   -noconfig    ignore .gotests.yml and .gotests.json config files
 //
 //   -nosubtests  disable subtest generation when >= Go 1.7
 //
-//   -w           write output to (test) files instead of stdout
+//   -number-cases
+//                name the subtests after the indexes of their test cases, as
+//                case_0, case_1, and so on, instead of a name field of the test
+//                cases. Not used with -nosubtests or -table map
+//
+//   -overwrite   replace the existing test files, regenerating the tests they
+//                have. Requires -all. The functions tested in the other test
+//                files of the package are still skipped
+//
+//   -p           number of files to process concurrently. Defaults to
+//                GOMAXPROCS
+//
+//   -packages    resolve the types of the imports with go/packages, as the go
+//                command does, such as those of other packages of the module.
+//                Falls back to parsing the imports when the package can't be
+//                loaded
+//
+//   -panics      add wantPanic and wantPanicMsg fields to the test cases, and
+//                recover from the panics of the calls to check them
+//
+//   -parallel    run subtests in parallel with t.Parallel
+//
+//   -perm        octal permissions of the test files created, such as 0664,
+//                before the umask. Defaults to 0644
+//
+//   -q           only report errors, not the generated tests
+//
+//   -r           walk directories recursively, skipping vendor, testdata, and
+//                hidden directories, and the paths matching the patterns of
+//                a .gotestsignore file in the current directory
+//
+//   -scaffold    construct the channel and function arguments that the test
+//                cases leave nil, with make and stubs returning zero values,
+//                and seed the tests of functions with functional options,
+//                such as ...Option, with a case listing the With functions
+//                returning them
+//
+//   -skip-noresult
+//                skip the functions without results, io.Writer parameters, or
+//                an error, whose tests would have nothing to assert, unless
+//                -only matches them
+//
+//   -skip-unexposable
+//                with -external, skip the functions with unexported types in
+//                their signature, instead of generating tests calling them
+//                with the arguments they can construct
+//
+//   -sortslices  sort the slice results and wanted values of ordered elements
+//                before comparing them, so that their order doesn't matter
+//
+//   -split       write the test of each function to a file of its own, such
+//                as foo_bar_test.go for Bar in foo.go, or
+//                foo_recv_method_test.go for a method
+//
+//   -stdout      capture the output of the functions printing to os.Stdout,
+//                with the fmt.Print functions or os.Stdout, and compare it
+//                against a wantOutput field of the test cases. Not used with
+//                -parallel
+//
+//   -summary     print the numbers of paths processed, tests generated,
+//                functions skipped, and files written at the end
+//
+//   -table       the container of the test cases: slice of structs with a
+//                name field, or map keyed by the case names. Defaults to
+//                slice
+//
+//   -tags        comma-separated build tags, such as integration, that new
+//                test files are constrained to with //go:build and // +build
+//                lines, so that go test only runs them with -tags
+//
+//   -template-dir
+//                directory of .tmpl files overriding the built-in templates
+//
+//   -template-params
+//                JSON object of values available to the templates as
+//                .TemplateParams. Keys that aren't set render as empty
+//
+//   -testmain    add a TestMain function, with TODO setup and teardown
+//                sections around m.Run, to the first new test file of each
+//                package whose test files don't declare one yet
+//
+//   -testname    template of the test names, such as Test_{{.Package}}_{{.Name}},
+//                executed with the Receiver type name, empty for functions,
+//                the Name, and the Package of each function. Defaults to
+//                TestFunc and TestType_Method
+//
+//   -v           also report the functions skipped and why
+//
+//   -variadic-cases
+//                seed the test cases of variadic functions with none and two
+//                variadic arguments
+//
+//   -w           write output to (test) files instead of stdout. Files that
+//                already have the output are left untouched
+//
+//   -watch       keep running, and regenerate the tests of the source files
+//                written or created under the paths until interrupted.
+//                Requires -w
 package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	"github.com/cweill/gotests/gotests/process"
 )
 
 var (
-	onlyFuncs     = flag.String("only", "", `regexp. generate tests for functions and methods that match only. Takes precedence over -all`)
-	exclFuncs     = flag.String("excl", "", `regexp. generate tests for functions and methods that don't match. Takes precedence over -only, -exported, and -all`)
-	exportedFuncs = flag.Bool("exported", false, `generate tests for exported functions and methods. Takes precedence over -only and -all`)
-	allFuncs      = flag.Bool("all", false, "generate tests for all functions and methods")
-	printInputs   = flag.Bool("i", false, "print test inputs in error messages")
-	writeOutput   = flag.Bool("w", false, "write output to (test) files instead of stdout")
-	allowError    = flag.Bool("allow", false, "allow error during test")
+	onlyFuncs      = flag.String("only", "", `regexp. generate tests for functions and methods that match only. Applies on top of -all and -exported`)
+	exclFuncs      = flag.String("excl", "", `regexp. generate tests for functions and methods that don't match. Applies on top of -all and -exported, and excludes the functions that -only matches too`)
+	exportedFuncs  = flag.Bool("exported", false, `generate tests for exported functions and methods only. Applies on top of -all, -only, and -excl`)
+	allFuncs       = flag.Bool("all", false, "generate tests for all functions and methods, narrowed by -exported, -only, and -excl")
+	printInputs    = flag.Bool("i", false, "print test inputs in error messages")
+	writeOutput    = flag.Bool("w", false, "write output to (test) files instead of stdout. Files that already have the output are left untouched")
+	allowError     = flag.Bool("allow", false, "keep generating the tests of the other paths when one fails, and report all the failures at the end")
+	benchmarks     = flag.Bool("bench", false, "generate benchmarks alongside tests")
+	fuzz           = flag.Bool("fuzz", false, "generate Go 1.18 fuzz targets for functions with only primitive parameters")
+	cmpDiff        = flag.Bool("cmp", false, "compare results with github.com/google/go-cmp/cmp.Diff")
+	merge          = flag.Bool("merge", false, "append new tests to existing test files, leaving their code untouched")
+	external       = flag.Bool("external", false, "generate blackbox tests in an external <pkg>_test package, unless most existing tests of the package are in the package itself. Skips unexported functions and methods")
+	fixImports     = flag.Bool("fiximports", true, "add missing and remove unused imports, as goimports does")
+	recursive      = flag.Bool("r", false, "walk directories recursively, skipping vendor, testdata, and hidden directories, and the paths matching the patterns of a .gotestsignore file in the current directory")
+	parallelism    = flag.Int("p", 0, "number of files to process concurrently. Defaults to GOMAXPROCS")
+	parallel       = flag.Bool("parallel", false, "run subtests in parallel with t.Parallel")
+	templateDir    = flag.String("template-dir", "", "directory of .tmpl files overriding the built-in templates")
+	templateParams = flag.String("template-params", "", "JSON object of values available to the templates as .TemplateParams. Keys that aren't set render as empty")
+	fillContext    = flag.Bool("fillcontext", true, "call functions with context.Background() for their context.Context parameters")
+	mockInterfaces = flag.Bool("mock", false, "pass mocks for single-method interface parameters, declared in the test file")
+	jsonOutput     = flag.Bool("json", false, "print a JSON array of the generated tests, with their path, test names, and source")
+	diff           = flag.Bool("diff", false, "print a unified diff against the existing test files instead of their output. Takes precedence over -w")
+	onlyList       = flag.String("only-names", "", "comma-separated names of functions and methods, as Func or Receiver.Method, to generate tests for in addition to -only")
+	exclList       = flag.String("excl-names", "", "comma-separated names of functions and methods, as Func or Receiver.Method, to exclude in addition to -excl")
+	onlyRecv       = flag.String("only-recv", "", "regexp. generate tests for the methods whose receiver type name matches only, and none of the functions. Applies on top of the other filters")
+	exclRecv       = flag.String("excl-recv", "", "regexp. generate tests for the functions, and the methods whose receiver type name doesn't match. Applies on top of the other filters")
+	cacheDir       = flag.String("cache", "", "directory of a cache by which the source files are skipped while they, the options, the templates and header files, the other files of their packages, and their test files are unchanged since their tests were last written. Requires -w")
+	pathsFrom      = flag.String("paths-from", "", "file listing the source files and directories to process after the arguments, one per line, or - to read them from stdin. Blank lines and lines starting with # are skipped. The missing paths fail the run, or are skipped with -allow")
+	cleanup        = flag.Bool("cleanup", false, "close the first result of functions with t.Cleanup, if it has a Close() error method")
+	helpers        = flag.Bool("helpers", false, "set up struct receivers with fields in a setupTest helper calling t.Helper. Only affects methods on such receivers")
+	caseVarName    = flag.String("case-var", "", `name of the table of test cases. Defaults to "tests"`)
+	argsStructName = flag.String("args-struct", "", `name of the struct type of the arguments, and its field in the test cases. Defaults to "args"`)
+	errorCmp       = flag.String("errcmp", "bool", "how to compare errors: bool checks for one, is with errors.Is against a wantErr error, and message against a wantErrMsg string")
+	examples       = flag.Bool("examples", false, "generate Example functions printing the results of exported functions. Requires -external or -force-external")
+	httpHandlers   = flag.Bool("http", false, "test functions with the signature of an http.HandlerFunc by calling them with an httptest request and recorder")
+	copyDoc        = flag.Bool("copydoc", false, "copy the doc comments of functions and methods to their tests")
+	outputPath     = flag.String("o", "", "template of the test file paths, such as {{.Dir}}/tests/{{.Name}}_test.go, where Dir is the directory and Name the base name without .go of each source file")
+	noConfig       = flag.Bool("noconfig", false, "ignore .gotests.yml and .gotests.json config files")
+	perm           = flag.String("perm", "", "octal permissions of the test files created, such as 0664, before the umask. Defaults to 0644")
+	quiet          = flag.Bool("q", false, "only report errors, not the generated tests")
+	verbose        = flag.Bool("v", false, "also report the functions skipped and why")
+	printSummary   = flag.Bool("summary", false, "print the numbers of paths processed, tests generated, functions skipped, and files written at the end")
+	relativePaths  = flag.Bool("relpaths", false, "log the paths of the source and test files, such as those of unchanged files and failed writes, relative to the current directory, or to -relpaths-base, instead of as given or absolute")
+	pathBase       = flag.String("relpaths-base", "", "with -relpaths, the directory that the logged paths are relative to, instead of the current directory")
+	assertion      = flag.String("assert", "should", "how to assert results: should with a should := require.New(t), or testify with the require functions, such as require.Equal(t, tt.want, got)")
+	splitFiles     = flag.Bool("split", false, "write the test of each function to a file of its own, such as foo_bar_test.go for Bar in foo.go, or foo_recv_method_test.go for a method")
+	headerComment  = flag.String("header", "", `comment at the top of new test files, such as a license header, or none. Defaults to "// Code generated by gotests. DO NOT EDIT."`)
+	headerFile     = flag.String("header-file", "", "file of the comment at the top of new test files. Takes precedence over -header")
+	variadicCases  = flag.Bool("variadic-cases", false, "seed the test cases of variadic functions with none and two variadic arguments")
+	scaffoldArgs   = flag.Bool("scaffold", false, "construct the channel and function arguments that the test cases leave nil, with make and stubs returning zero values, and seed the tests of functions with functional options, such as ...Option, with a case listing the With functions returning them")
+	panics         = flag.Bool("panics", false, "add wantPanic and wantPanicMsg fields to the test cases, and recover from the panics of the calls to check them")
+	overwrite      = flag.Bool("overwrite", false, "replace the existing test files, regenerating the tests they have. Requires -all. The functions tested in the other test files of the package are still skipped")
+	tableStyle     = flag.String("table", "slice", "the container of the test cases: slice of structs with a name field, or map keyed by the case names")
+	loadPackages   = flag.Bool("packages", false, "resolve the types of the imports with go/packages, as the go command does, such as those of other packages of the module. Falls back to parsing the imports when the package can't be loaded")
+	golden         = flag.Bool("golden", false, "compare the string and []byte results of functions against golden files under testdata, which the tests rewrite when run with -update")
+	messageFormat  = flag.String("msgfmt", "v", "the verb formatting the results in the messages of failed comparisons: v, +v to show the field names of structs, or #v for Go syntax")
+	benchSizes     = flag.String("bench-sizes", "", "comma-separated sizes, such as 10,100,1000, of the int or slice parameter that benchmarks run a sub-benchmark with. Requires -bench")
+	skipUnexpos    = flag.Bool("skip-unexposable", false, "with -external, skip the functions with unexported types in their signature, instead of generating tests calling them with the arguments they can construct")
+	envSetup       = flag.Bool("env", false, "set the environment variables that functions read with os.Getenv or os.LookupEnv to placeholders with t.Setenv in each test case. Not used with -parallel")
+	sortSlices     = flag.Bool("sortslices", false, "sort the slice results and wanted values of ordered elements before comparing them, so that their order doesn't matter")
+	testName       = flag.String("testname", "", "template of the test names, such as Test_{{.Package}}_{{.Name}}, executed with the Receiver type name, empty for functions, the Name, and the Package of each function. Defaults to TestFunc and TestType_Method")
+	skipNoResult   = flag.Bool("skip-noresult", false, "skip the functions without results, io.Writer parameters, or an error, whose tests would have nothing to assert, unless -only matches them")
+	forceInternal  = flag.Bool("force-internal", false, "generate tests in the package under test, even if most existing tests of the package are in its external _test package")
+	forceExternal  = flag.Bool("force-external", false, "generate blackbox tests in an external <pkg>_test package, even if most existing tests of the package are in the package itself. Skips unexported functions and methods")
+	includeGen     = flag.Bool("include-generated", false, `generate tests for the source files marked as generated by a "// Code generated ... DO NOT EDIT." comment too, which are skipped by default`)
+	caseTimeout    = flag.Duration("case-timeout", 0, "fail the subtests whose call takes longer than the timeout, such as 30s, which they make in a goroutine guarded by a context.WithTimeout. Not used with -nosubtests or -panics")
+	numberCases    = flag.Bool("number-cases", false, "name the subtests after the indexes of their test cases, as case_0, case_1, and so on, instead of a name field of the test cases. Not used with -nosubtests or -table map")
+	derefPointers  = flag.Bool("deref", false, "compare the values that pointer results and wanted values point to, once checked to be both nil or both non-nil, so that failed comparisons show the values rather than their addresses")
+	captureStdout  = flag.Bool("stdout", false, "capture the output of the functions printing to os.Stdout, with the fmt.Print functions or os.Stdout, and compare it against a wantOutput field of the test cases. Not used with -parallel")
+	cases          = flag.Int("cases", 0, "number of blank test cases, named case1, case2, and so on, to start the tables of test cases with, instead of a TODO comment")
+	buildTags      = flag.String("tags", "", "comma-separated build tags, such as integration, that new test files are constrained to with //go:build and // +build lines, so that go test only runs them with -tags")
+	line           = flag.Int("line", 0, "generate a test for only the function whose declaration, doc comment included, spans the line of the single source file, such as the one under the cursor of an editor")
+	offset         = flag.Int("offset", 0, "generate a test for only the function whose declaration, doc comment included, spans the byte offset of the single source file")
+	asyncPattern   = flag.Bool("async", false, "receive from the channels that functions return, failing the tests after a second without a value, and compare the value received against a want field of the element type")
+	boundaryCases  = flag.Bool("boundary", false, "seed the test cases with the boundary values of the integer and floating-point parameters: 0, their minimum and maximum, -1, and 1, as many of their combinations as fit in 8 test cases")
+	constructors   = flag.Bool("constructors", false, "build the receivers of methods with the New function of their type, such as NewFoo for Foo, if the package has one that returns only the type or a pointer to it, from the arguments of a setup field of the test cases, instead of a zero value")
+	testMain       = flag.Bool("testmain", false, "add a TestMain function, with TODO setup and teardown sections around m.Run, to the first new test file of each package whose test files don't declare one yet")
+	boolAsError    = flag.Bool("boolerr", false, "check the trailing bool result of functions without an error, such as ok in (value int, ok bool), as the success of the call, against a wantOk field of the test cases")
+	leakCheck      = flag.Bool("leakcheck", false, "defer goleak.VerifyNone(t) at the start of each test, failing it if goroutines it started are still running when it returns. Requires -leakcheck-main with -parallel")
+	leakCheckMain  = flag.Bool("leakcheck-main", false, "with -leakcheck, verify the whole package once with goleak.VerifyTestMain in a TestMain function, added as -testmain does, instead of in each test")
+	multilineCases = flag.Bool("multiline", false, "break the struct literals of the test cases with several fields, such as the arguments of the cases seeded by -boundary, onto one line per field")
+	useEqualMethod = flag.Bool("equal", false, "compare the results whose type has an Equal(T) bool method, such as time.Time, with it, as in !got.Equal(tt.want), instead of field by field")
+	coverageHints  = flag.Bool("hints", false, "comment the tests with a list of the conditions of the if, switch, and for statements of the functions they test, such as if x > 0, as a checklist of the test cases to add")
+	testingPkg     = flag.String("testing-pkg", "", "import path of a package wrapping testing, such as example.com/xtesting, whose T type the tests take instead of *testing.T. Its T must have the methods of testing.T that the tests call")
+	lintFriendly   = flag.Bool("lint", false, "generate tests that pass strict linters: copy the test cases with tt := tt in the subtests, and annotate the tests with //nolint:paralleltest, or //nolint:tparallel with -parallel, since only their subtests run in parallel")
+	skipEmpty      = flag.Bool("skip-empty", false, "skip the tests without generated test cases with t.Skip, so that they don't pass until their cases are filled in")
+	concurrent     = flag.Bool("concurrent", false, "add a concurrent subtest to each test case, calling the function from several goroutines at once under a sync.WaitGroup. Only meaningful with go test -race, which reports the data races of the calls")
+	autoName       = flag.Bool("autoname", false, "name the subtests after the arguments of their test cases, formatted with %v, instead of a name field of the test cases. Not used with -nosubtests or -table map")
+	fieldDiff      = flag.Bool("fielddiff", false, "compare the struct results field by field, so that failed comparisons name the fields that differ. With -cmp, the fields are compared with != when they can be, rather than with cmp.Diff")
+	goVersion      = flag.String("go", "", "version of Go, such as 1.22, that the tests are generated for, which decides the idioms they use, such as tt := tt in parallel subtests before Go 1.22. Defaults to the version of the Go toolchain")
+	tempDir        = flag.Bool("tempdir", false, "set the parameters that look like paths up in a t.TempDir of each test case that leaves them unset: string parameters named dir, or ending in Dir, with the directory, those named path or file, or ending in Path or File, with a path in it, and *os.File parameters with a file created in it")
+	groupRecv      = flag.Bool("group-recv", false, "group the tests of the functions, then those of the methods of each receiver type, in sections starting with a comment such as // Tests for type Foo")
+	seedErr        = flag.Bool("seed-err", false, "seed the tests of the functions returning an error with a \"success\" test case, and an \"error\" one wanting the error")
+	watch          = flag.Bool("watch", false, "keep running, and regenerate the tests of the source files written or created under the paths until interrupted. Requires -w")
 )
 
 // nosubtests is always set to default value of true when Go < 1.7.
@@ -52,15 +498,137 @@ var nosubtests = true
 func main() {
 	flag.Parse()
 	args := flag.Args()
+	// Record the flags set on the command line, which take precedence over
+	// the config file.
+	var fileMode os.FileMode
+	if *perm != "" {
+		var err error
+		if fileMode, err = process.ParseFileMode(*perm); err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid -perm mode: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	if *quiet && *verbose {
+		fmt.Fprintln(os.Stderr, "Please specify only one of the -q and -v flags")
+		os.Exit(1)
+	}
+	var verbosity int
+	if *quiet {
+		verbosity = process.Quiet
+	} else if *verbose {
+		verbosity = process.Verbose
+	}
+	flags := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "nosubtests":
+			flags["subtests"] = true
+		case "q", "v":
+			flags["verbosity"] = true
+		default:
+			flags[f.Name] = true
+		}
+	})
 
-	process.Run(os.Stdout, args, &process.Options{
-		OnlyFuncs:     *onlyFuncs,
-		ExclFuncs:     *exclFuncs,
-		ExportedFuncs: *exportedFuncs,
-		AllFuncs:      *allFuncs,
-		PrintInputs:   *printInputs,
-		Subtests:      !nosubtests,
-		WriteOutput:   *writeOutput,
-		AllowError:    *allowError,
+	err := process.Run(os.Stdout, args, &process.Options{
+		OnlyFuncs:           *onlyFuncs,
+		ExclFuncs:           *exclFuncs,
+		OnlyList:            *onlyList,
+		ExclList:            *exclList,
+		OnlyReceiver:        *onlyRecv,
+		ExclReceiver:        *exclRecv,
+		PathsFrom:           *pathsFrom,
+		CacheDir:            *cacheDir,
+		ExportedFuncs:       *exportedFuncs,
+		AllFuncs:            *allFuncs,
+		PrintInputs:         *printInputs,
+		Subtests:            !nosubtests,
+		WriteOutput:         *writeOutput,
+		AllowError:          *allowError,
+		Benchmarks:          *benchmarks,
+		BenchSizes:          *benchSizes,
+		Fuzz:                *fuzz,
+		CmpDiff:             *cmpDiff,
+		Merge:               *merge,
+		External:            *external,
+		SkipUnexposable:     *skipUnexpos,
+		EnvSetup:            *envSetup,
+		SortSlices:          *sortSlices,
+		TestNameTemplate:    *testName,
+		SkipNoResult:        *skipNoResult,
+		ForceInternal:       *forceInternal,
+		ForceExternal:       *forceExternal,
+		IncludeGenerated:    *includeGen,
+		CaseTimeout:         *caseTimeout,
+		NumberCases:         *numberCases,
+		DerefPointers:       *derefPointers,
+		CaptureStdout:       *captureStdout,
+		Cases:               *cases,
+		BuildTags:           *buildTags,
+		Watch:               *watch,
+		Line:                *line,
+		Offset:              *offset,
+		AsyncPattern:        *asyncPattern,
+		BoundaryCases:       *boundaryCases,
+		UseConstructors:     *constructors,
+		GenerateTestMain:    *testMain,
+		BoolAsError:         *boolAsError,
+		LeakCheck:           *leakCheck,
+		LeakCheckMain:       *leakCheckMain,
+		MultilineCases:      *multilineCases,
+		UseEqualMethod:      *useEqualMethod,
+		CoverageHints:       *coverageHints,
+		TestingPkg:          *testingPkg,
+		LintFriendly:        *lintFriendly,
+		SkipEmpty:           *skipEmpty,
+		ConcurrencyCase:     *concurrent,
+		AutoName:            *autoName,
+		FieldDiff:           *fieldDiff,
+		GoVersion:           *goVersion,
+		TempDirForPaths:     *tempDir,
+		GroupByReceiver:     *groupRecv,
+		SeedErrorCase:       *seedErr,
+		NoFixImports:        !*fixImports,
+		Recursive:           *recursive,
+		Parallel:            *parallel,
+		Parallelism:         *parallelism,
+		TemplateDir:         *templateDir,
+		TemplateParams:      *templateParams,
+		NoFillContext:       !*fillContext,
+		MockInterfaces:      *mockInterfaces,
+		JSONOutput:          *jsonOutput,
+		Cleanup:             *cleanup,
+		Helpers:             *helpers,
+		ErrorComparison:     *errorCmp,
+		CaseVarName:         *caseVarName,
+		ArgsStructName:      *argsStructName,
+		Examples:            *examples,
+		HTTPHandlers:        *httpHandlers,
+		CopyDoc:             *copyDoc,
+		OutputPath:          *outputPath,
+		Diff:                *diff,
+		Flags:               flags,
+		NoConfig:            *noConfig,
+		FileMode:            fileMode,
+		Verbosity:           verbosity,
+		PrintSummary:        *printSummary,
+		RelativePaths:       *relativePaths,
+		PathBase:            *pathBase,
+		Assertion:           *assertion,
+		SplitFiles:          *splitFiles,
+		HeaderComment:       *headerComment,
+		HeaderFile:          *headerFile,
+		VariadicCases:       *variadicCases,
+		ScaffoldComplexArgs: *scaffoldArgs,
+		Panics:              *panics,
+		Overwrite:           *overwrite,
+		TableStyle:          *tableStyle,
+		LoadPackages:        *loadPackages,
+		Golden:              *golden,
+		MessageFormat:       *messageFormat,
 	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 }
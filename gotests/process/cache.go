@@ -0,0 +1,178 @@
+package process
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cweill/gotests"
+)
+
+// A cache records, in a file of its directory per source file, the hashes
+// of the source files, the options and the template and header files they
+// name, the other Go files of their packages, and the test files generated,
+// so that the runs with a CacheDir can skip the source files whose tests are
+// current.
+type cache struct {
+	dir     string
+	options string
+}
+
+// A cacheEntry holds the hashes recorded for a source file.
+type cacheEntry struct {
+	Source  string            `json:"source"`
+	Options string            `json:"options"`
+	Package string            `json:"package"` // Of the other Go files.
+	Outputs map[string]string `json:"outputs"` // By test file path.
+}
+
+// newCache returns the cache of opts, or nil if it has none. The tests are
+// only cached when they're written to their files.
+func newCache(opts *Options) *cache {
+	if opts.CacheDir == "" || !opts.WriteOutput || opts.Diff || opts.JSONOutput {
+		return nil
+	}
+	return &cache{dir: opts.CacheDir, options: optionsHash(opts)}
+}
+
+// optionsHash returns the hash of the options of opts that config files can
+// set, which are those deciding the tests generated, and of the content of
+// the files in TemplateDir and of the HeaderFile.
+func optionsHash(opts *Options) string {
+	keys := make([]string, 0, len(configFields))
+	for k := range configFields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	v := reflect.ValueOf(opts).Elem()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%v=%v\n", k, v.FieldByName(strings.TrimPrefix(configFields[k], "!")).Interface())
+	}
+	if opts.TemplateDir != "" {
+		if fis, err := ioutil.ReadDir(opts.TemplateDir); err == nil {
+			for _, fi := range fis {
+				if !fi.IsDir() {
+					fmt.Fprintf(h, "template %v=%v\n", fi.Name(), fileHash(filepath.Join(opts.TemplateDir, fi.Name())))
+				}
+			}
+		}
+	}
+	if opts.HeaderFile != "" {
+		fmt.Fprintf(h, "header=%v\n", fileHash(opts.HeaderFile))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// packageHash returns the hash of the Go files in the directory of the
+// source file at path, apart from it and the test files in outputs, which
+// the tests generated for it depend on for their types and existing tests.
+func packageHash(path string, outputs map[string]string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return ""
+	}
+	dir := filepath.Dir(abs)
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+	h := sha256.New()
+	for _, fi := range fis {
+		p := filepath.Join(dir, fi.Name())
+		if _, ok := outputs[p]; ok || p == abs || fi.IsDir() || filepath.Ext(p) != ".go" {
+			continue
+		}
+		fmt.Fprintf(h, "%v=%v\n", fi.Name(), fileHash(p))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// fileHash returns the hash of the file at path, or "" if it can't be read.
+func fileHash(path string) string {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return hash(b)
+}
+
+func hash(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// entryPath returns the path of the file of the entry of the source path.
+func (c *cache) entryPath(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(c.dir, hash([]byte(abs))+".json"), nil
+}
+
+// check returns the hash of the source file at path, or "" if it isn't a
+// file, and the number of the test files generated for it if its entry has
+// the same source, options, and package, and the test files still have the
+// output recorded, or -1 otherwise.
+func (c *cache) check(path string) (string, int) {
+	if fi, err := os.Stat(path); err != nil || fi.IsDir() {
+		return "", -1
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", -1
+	}
+	src := hash(b)
+	ep, err := c.entryPath(path)
+	if err != nil {
+		return src, -1
+	}
+	b, err = ioutil.ReadFile(ep)
+	if err != nil {
+		return src, -1
+	}
+	var e cacheEntry
+	if err := json.Unmarshal(b, &e); err != nil || e.Source != src || e.Options != c.options || e.Package != packageHash(path, e.Outputs) {
+		return src, -1
+	}
+	for p, h := range e.Outputs {
+		if b, err := ioutil.ReadFile(p); err != nil || hash(b) != h {
+			return src, -1
+		}
+	}
+	return src, len(e.Outputs)
+}
+
+// store records the tests gts generated for the source file at path, whose
+// hash is src.
+func (c *cache) store(path, src string, gts []*gotests.GeneratedTest) error {
+	e := cacheEntry{Source: src, Options: c.options, Outputs: make(map[string]string)}
+	for _, t := range gts {
+		p, err := filepath.Abs(t.Path)
+		if err != nil {
+			return err
+		}
+		e.Outputs[p] = hash(t.Output)
+	}
+	e.Package = packageHash(path, e.Outputs)
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	ep, err := c.entryPath(path)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.dir, newDirPerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(ep, b, newFilePerm)
+}
@@ -0,0 +1,278 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The names of the config files, in the order they're looked for in each
+// directory.
+var configNames = []string{".gotests.yml", ".gotests.yaml", ".gotests.json"}
+
+// The fields of Options set by each config file key. The keys are the names
+// of the corresponding command-line flags, except for subtests, which is the
+// inverse of -nosubtests, and verbosity, which is set by -q and -v. The bool
+// fields starting with ! are set to the inverse of their key's value.
+var configFields = map[string]string{
+	"only":              "OnlyFuncs",
+	"excl":              "ExclFuncs",
+	"only-names":        "OnlyList",
+	"excl-names":        "ExclList",
+	"only-recv":         "OnlyReceiver",
+	"excl-recv":         "ExclReceiver",
+	"exported":          "ExportedFuncs",
+	"all":               "AllFuncs",
+	"i":                 "PrintInputs",
+	"subtests":          "Subtests",
+	"w":                 "WriteOutput",
+	"allow":             "AllowError",
+	"bench":             "Benchmarks",
+	"fuzz":              "Fuzz",
+	"cmp":               "CmpDiff",
+	"merge":             "Merge",
+	"external":          "External",
+	"fiximports":        "!NoFixImports",
+	"r":                 "Recursive",
+	"parallel":          "Parallel",
+	"fillcontext":       "!NoFillContext",
+	"mock":              "MockInterfaces",
+	"cleanup":           "Cleanup",
+	"helpers":           "Helpers",
+	"errcmp":            "ErrorComparison",
+	"case-var":          "CaseVarName",
+	"args-struct":       "ArgsStructName",
+	"examples":          "Examples",
+	"http":              "HTTPHandlers",
+	"copydoc":           "CopyDoc",
+	"template-dir":      "TemplateDir",
+	"template-params":   "TemplateParams",
+	"p":                 "Parallelism",
+	"json":              "JSONOutput",
+	"diff":              "Diff",
+	"o":                 "OutputPath",
+	"perm":              "FileMode",
+	"verbosity":         "Verbosity",
+	"summary":           "PrintSummary",
+	"relpaths":          "RelativePaths",
+	"relpaths-base":     "PathBase",
+	"assert":            "Assertion",
+	"split":             "SplitFiles",
+	"header":            "HeaderComment",
+	"header-file":       "HeaderFile",
+	"variadic-cases":    "VariadicCases",
+	"scaffold":          "ScaffoldComplexArgs",
+	"panics":            "Panics",
+	"overwrite":         "Overwrite",
+	"table":             "TableStyle",
+	"packages":          "LoadPackages",
+	"golden":            "Golden",
+	"msgfmt":            "MessageFormat",
+	"bench-sizes":       "BenchSizes",
+	"skip-unexposable":  "SkipUnexposable",
+	"env":               "EnvSetup",
+	"sortslices":        "SortSlices",
+	"testname":          "TestNameTemplate",
+	"skip-noresult":     "SkipNoResult",
+	"force-internal":    "ForceInternal",
+	"force-external":    "ForceExternal",
+	"include-generated": "IncludeGenerated",
+	"case-timeout":      "CaseTimeout",
+	"number-cases":      "NumberCases",
+	"deref":             "DerefPointers",
+	"stdout":            "CaptureStdout",
+	"cases":             "Cases",
+	"tags":              "BuildTags",
+	"watch":             "Watch",
+	"async":             "AsyncPattern",
+	"boundary":          "BoundaryCases",
+	"constructors":      "UseConstructors",
+	"testmain":          "GenerateTestMain",
+	"boolerr":           "BoolAsError",
+	"leakcheck":         "LeakCheck",
+	"leakcheck-main":    "LeakCheckMain",
+	"multiline":         "MultilineCases",
+	"equal":             "UseEqualMethod",
+	"hints":             "CoverageHints",
+	"testing-pkg":       "TestingPkg",
+	"lint":              "LintFriendly",
+	"skip-empty":        "SkipEmpty",
+	"concurrent":        "ConcurrencyCase",
+	"autoname":          "AutoName",
+	"fielddiff":         "FieldDiff",
+	"go":                "GoVersion",
+	"tempdir":           "TempDirForPaths",
+	"group-recv":        "GroupByReceiver",
+	"seed-err":          "SeedErrorCase",
+	"cache":             "CacheDir",
+}
+
+// findConfig returns the path of the config file in dir or its closest
+// parent that has one, or "" if there is none.
+func findConfig(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		for _, name := range configNames {
+			path := filepath.Join(dir, name)
+			if fi, err := os.Stat(path); err == nil && !fi.IsDir() {
+				return path, nil
+			}
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// readConfig returns the values of the config file at path by key.
+func readConfig(path string) (map[string]interface{}, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := map[string]interface{}{}
+	if filepath.Ext(path) == ".json" {
+		err = json.Unmarshal(b, &cfg)
+	} else {
+		err = yaml.Unmarshal(b, &cfg)
+	}
+	return cfg, err
+}
+
+// applyConfig returns a copy of opts with the defaults of the config file
+// found from opts.ConfigDir. Options set on the command line, as recorded in
+// opts.Flags, take precedence over the file. Without opts.Flags, only the
+// options left at their zero value are taken from the file. A config file
+// that can't be read, and keys that can't be applied, are reported to
+// opts.Stderr and ignored.
+func applyConfig(opts *Options) *Options {
+	o := *opts
+	if o.NoConfig {
+		return &o
+	}
+	stderr := o.Stderr
+	if stderr == nil {
+		stderr = os.Stderr
+	}
+	dir := o.ConfigDir
+	if dir == "" {
+		dir = "."
+	}
+	path, err := findConfig(dir)
+	if err != nil {
+		fmt.Fprintf(stderr, "Warning: ignoring config file: %v\n", err)
+		return &o
+	}
+	if path == "" {
+		return &o
+	}
+	cfg, err := readConfig(path)
+	if err != nil {
+		fmt.Fprintf(stderr, "Warning: ignoring %v: %v\n", path, err)
+		return &o
+	}
+	keys := make([]string, 0, len(cfg))
+	for k := range cfg {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	v := reflect.ValueOf(&o).Elem()
+	for _, k := range keys {
+		name, ok := configFields[k]
+		if !ok {
+			fmt.Fprintf(stderr, "Warning: ignoring unknown option %q in %v\n", k, path)
+			continue
+		}
+		f := v.FieldByName(strings.TrimPrefix(name, "!"))
+		if o.Flags != nil && o.Flags[k] || o.Flags == nil && !f.IsZero() {
+			continue
+		}
+		if err := setField(f, cfg[k]); err != nil {
+			fmt.Fprintf(stderr, "Warning: ignoring option %q in %v: %v\n", k, path, err)
+		} else if strings.HasPrefix(name, "!") {
+			f.SetBool(!f.Bool())
+		}
+	}
+	return &o
+}
+
+// setField sets f to the config file value x, which must be of f's kind.
+func setField(f reflect.Value, x interface{}) error {
+	if f.Type() == reflect.TypeOf(time.Duration(0)) {
+		// Durations are strings such as 30s.
+		s, ok := x.(string)
+		if !ok {
+			return fmt.Errorf("%v is not a duration", x)
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		f.SetInt(int64(d))
+		return nil
+	}
+	if f.Type() == reflect.TypeOf(os.FileMode(0)) {
+		// Modes are octal strings, or YAML octal integers such as 0664.
+		var m os.FileMode
+		switch n := x.(type) {
+		case string:
+			var err error
+			if m, err = ParseFileMode(n); err != nil {
+				return err
+			}
+		case int:
+			if n < 0 {
+				return fmt.Errorf("%v is not an octal mode", x)
+			}
+			m = os.FileMode(n)
+			if err := checkFileMode(m); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%v is not an octal mode", x)
+		}
+		f.SetUint(uint64(m))
+		return nil
+	}
+	switch f.Kind() {
+	case reflect.Bool:
+		b, ok := x.(bool)
+		if !ok {
+			return fmt.Errorf("%v is not a boolean", x)
+		}
+		f.SetBool(b)
+	case reflect.String:
+		s, ok := x.(string)
+		if !ok {
+			return fmt.Errorf("%v is not a string", x)
+		}
+		f.SetString(s)
+	case reflect.Int:
+		switch n := x.(type) {
+		case int:
+			f.SetInt(int64(n))
+		case float64:
+			// JSON numbers are decoded as float64.
+			if n != float64(int64(n)) {
+				return fmt.Errorf("%v is not an integer", x)
+			}
+			f.SetInt(int64(n))
+		default:
+			return fmt.Errorf("%v is not an integer", x)
+		}
+	}
+	return nil
+}
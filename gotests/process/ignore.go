@@ -0,0 +1,139 @@
+package process
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// The name of the file of the paths for the recursive walks to skip, in the
+// syntax of .gitignore files.
+const ignoreName = ".gotestsignore"
+
+// An ignoreList holds the patterns of an ignore file, which match the paths
+// relative to its directory root.
+type ignoreList struct {
+	root     string
+	patterns []ignorePattern
+}
+
+// An ignorePattern is a line of an ignore file, split into the segments
+// matching those of a path, where ** matches any number of them.
+type ignorePattern struct {
+	segs    []string
+	negate  bool // Whether the paths it matches are included again.
+	dirOnly bool // Whether it only matches directories.
+}
+
+// readIgnore returns the ignoreList of the ignore file in dir, or nil if there
+// is none.
+func readIgnore(dir string) (*ignoreList, error) {
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadFile(filepath.Join(root, ignoreName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	l := &ignoreList{root: root}
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimRight(s.Text(), " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		p, err := parseIgnorePattern(line)
+		if err != nil {
+			return nil, fmt.Errorf("%v:%v: %v", ignoreName, n, err)
+		}
+		l.patterns = append(l.patterns, p)
+	}
+	return l, s.Err()
+}
+
+// parseIgnorePattern parses a line of an ignore file. As in .gitignore files,
+// a leading ! negates it, a trailing / only matches directories, and it only
+// matches the paths relative to the root if it has a / elsewhere, or else
+// their base names.
+func parseIgnorePattern(line string) (ignorePattern, error) {
+	var p ignorePattern
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\`) {
+		// Escapes a leading ! or #.
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if line == "" {
+		return p, fmt.Errorf("empty pattern")
+	}
+	if !strings.Contains(line, "/") {
+		line = "**/" + line
+	}
+	p.segs = strings.Split(strings.TrimPrefix(line, "/"), "/")
+	for _, seg := range p.segs {
+		if _, err := path.Match(seg, ""); err != nil {
+			return p, fmt.Errorf("invalid pattern %q", line)
+		}
+	}
+	return p, nil
+}
+
+// ignored reports whether the last pattern of l matching path, which is a
+// directory if dir is set, ignores it. Paths outside of the root of l, and
+// the root itself, aren't ignored. A nil ignoreList ignores nothing.
+func (l *ignoreList) ignored(p string, dir bool) bool {
+	if l == nil {
+		return false
+	}
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(l.root, abs)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return false
+	}
+	segs := strings.Split(filepath.ToSlash(rel), "/")
+	var ignored bool
+	for _, pat := range l.patterns {
+		if (!pat.dirOnly || dir) && matchSegs(pat.segs, segs) {
+			ignored = !pat.negate
+		}
+	}
+	return ignored
+}
+
+// matchSegs reports whether the pattern segments pat match the path
+// segments segs.
+func matchSegs(pat, segs []string) bool {
+	if len(pat) == 0 {
+		return len(segs) == 0
+	}
+	if pat[0] == "**" {
+		for i := 0; i <= len(segs); i++ {
+			if matchSegs(pat[1:], segs[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segs) == 0 {
+		return false
+	}
+	ok, _ := path.Match(pat[0], segs[0])
+	return ok && matchSegs(pat[1:], segs[1:])
+}
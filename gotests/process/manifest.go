@@ -0,0 +1,60 @@
+package process
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// readManifest returns the paths listed in the file name, or in stdin, or
+// os.Stdin if it's nil, for the "-" name, one per line. Blank lines and the
+// comment lines starting with # are skipped.
+func readManifest(name string, stdin io.Reader) ([]string, error) {
+	in := stdin
+	if name != stdinArg {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		in = f
+	} else if in == nil {
+		in = os.Stdin
+	}
+	var paths []string
+	s := bufio.NewScanner(in)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		paths = append(paths, line)
+	}
+	return paths, s.Err()
+}
+
+// manifestPaths returns the paths listed in the manifest of opts that exist.
+// The missing ones fail the run, unless opts.AllowError is set, in which case
+// they're logged to log and skipped.
+func manifestPaths(log io.Writer, opts *Options) ([]string, error) {
+	ps, err := readManifest(opts.PathsFrom, opts.Stdin)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid -paths-from list: %v", err)
+	}
+	var paths []string
+	for _, p := range ps {
+		if _, err := os.Stat(p); err != nil {
+			if !opts.AllowError {
+				return nil, fmt.Errorf("Invalid -paths-from list: %v", relativeError(err, opts))
+			}
+			if opts.Verbosity > Quiet {
+				fmt.Fprintf(log, "Skipped missing path %v\n", opts.logPath(p))
+			}
+			continue
+		}
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
@@ -4,72 +4,737 @@
 package process
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"go/build/constraint"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
 	"io"
+	"io/fs"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
 
 	"github.com/cweill/gotests"
+	"github.com/cweill/gotests/internal/diff"
+	"github.com/cweill/gotests/internal/fields"
+	"github.com/cweill/gotests/internal/models"
 )
 
-const newFilePerm os.FileMode = 0644
+// The Go versions, such as 1.22, go1.22, or 1.22.1, that -go accepts.
+var goVersionRE = regexp.MustCompile(`^(go)?1\.\d+(\.\d+)?$`)
+
+const (
+	newFilePerm os.FileMode = 0644
+	newDirPerm  os.FileMode = 0755
+)
+
+// Levels of Options.Verbosity, around the default of 0.
+const (
+	Quiet   = -1
+	Verbose = 1
+)
+
+// The argument to read the source from stdin.
+const stdinArg = "-"
 
 // Set of options to use when generating tests.
 type Options struct {
-	OnlyFuncs     string // Regexp string for filter matches.
-	ExclFuncs     string // Regexp string for excluding matches.
-	ExportedFuncs bool   // Only include exported functions.
-	AllFuncs      bool   // Include all non-tested functions.
-	PrintInputs   bool   // Print function parameters as part of error messages.
-	Subtests      bool   // Print tests using Go 1.7 subtests
-	WriteOutput   bool   // Write output to test file(s).
-	AllowError    bool   // allow error during test, otherwise exit when error occurs
+	OnlyFuncs       string // Regexp string for filter matches.
+	ExclFuncs       string // Regexp string for excluding matches.
+	OnlyList        string // Comma-separated names of functions to include, in addition to OnlyFuncs.
+	ExclList        string // Comma-separated names of functions to exclude, in addition to ExclFuncs.
+	OnlyReceiver    string // Regexp string matching the receiver type names of the methods to include.
+	ExclReceiver    string // Regexp string matching the receiver type names of the methods to exclude.
+	ExportedFuncs   bool   // Only include exported functions.
+	AllFuncs        bool   // Include all non-tested functions, narrowed by the other filters.
+	PrintInputs     bool   // Print function parameters as part of error messages.
+	Subtests        bool   // Print tests using Go 1.7 subtests
+	WriteOutput     bool   // Write output to test file(s).
+	AllowError      bool   // Keep processing the other paths when one fails, and return all the failures.
+	Benchmarks      bool   // Generate benchmarks alongside tests.
+	Fuzz            bool   // Generate fuzz targets for functions with primitive parameters.
+	CmpDiff         bool   // Compare results with cmp.Diff.
+	Merge           bool   // Append new tests to existing test files.
+	External        bool   // Generate tests in an external _test package.
+	NoFixImports    bool   // Leave the imports of the generated tests as rendered, rather than fix them with goimports.
+	Recursive       bool   // Walk directories recursively, skipping the paths of the .gotestsignore file.
+	Parallel        bool   // Run subtests in parallel.
+	NoFillContext   bool   // Pass the context.Context parameters in the test cases, rather than context.Background().
+	MockInterfaces  bool   // Pass mocks for single-method interface parameters.
+	Cleanup         bool   // Close the first result of functions with t.Cleanup, if it's an io.Closer.
+	Helpers         bool   // Set up struct receivers with fields in a setupTest helper.
+	ErrorComparison string // How to compare errors: "bool", "is", or "message".
+	Assertion       string // How to assert results: "should" or "testify".
+	SplitFiles      bool   // Write the test of each function to a file of its own.
+	VariadicCases   bool   // Seed the test cases of variadic functions.
+	// Construct the channel and function arguments that the test cases
+	// leave nil.
+	ScaffoldComplexArgs bool
+	Panics              bool   // Check whether the calls panic as the test cases expect.
+	Overwrite           bool   // Replace the existing test files. Requires AllFuncs.
+	TableStyle          string // The container of the test cases: "slice" or "map".
+	LoadPackages        bool   // Resolve the types of the imports with go/packages.
+	Golden              bool   // Compare string and []byte results against golden files.
+	MessageFormat       string // The verb of results in messages: "v", "+v", or "#v".
+	BenchSizes          string // Comma-separated sizes of the sub-benchmarks. Requires Benchmarks.
+	SkipUnexposable     bool   // Skip the External tests of functions with unexported types in their signature.
+	EnvSetup            bool   // Set the environment variables read by functions with t.Setenv.
+	SortSlices          bool   // Compare slice results regardless of their order.
+	SkipNoResult        bool   // Skip the functions whose tests would have nothing to assert, unless OnlyFuncs matches them.
+	ForceInternal       bool   // Generate tests in the package under test, whatever package its existing tests are in.
+	ForceExternal       bool   // Generate tests in an external _test package, whatever package the existing tests are in.
+	IncludeGenerated    bool   // Generate tests for the source files marked as generated too.
+	CaseVarName         string // Name of the table of test cases.
+	ArgsStructName      string // Name of the struct type of the arguments.
+	Examples            bool   // Generate Example functions. Requires External.
+	HTTPHandlers        bool   // Test HTTP handlers with httptest.
+	CopyDoc             bool   // Copy the doc comments of the functions to their tests.
+	TemplateDir         string // Directory of custom templates.
+	TemplateParams      string // JSON object of values available to the templates.
+	Parallelism         int    // Number of paths to process concurrently. Defaults to GOMAXPROCS.
+	JSONOutput          bool   // Print a JSON array of the generated tests instead.
+	Diff                bool   // Print a unified diff against the existing test files instead.
+	// Template of the test names, such as Test_{{.Package}}_{{.Name}},
+	// executed with the Receiver type name, Name, and Package of each
+	// function.
+	TestNameTemplate string
+	// Fail the subtests whose call takes longer. Requires Subtests, and
+	// can't be used with Panics.
+	CaseTimeout time.Duration
+	// Name the subtests after the indexes of their test cases. Requires
+	// Subtests, and can't be used with the "map" TableStyle.
+	NumberCases bool
+	// Compare the values that pointer results point to.
+	DerefPointers bool
+	// Capture and compare what functions print to os.Stdout. Can't be used
+	// with Parallel.
+	CaptureStdout bool
+	// Number of blank test cases to start the tables of test cases with.
+	Cases int
+	// Seed the test cases with the boundary values of the numeric
+	// parameters.
+	BoundaryCases bool
+	// Receive from the channels that functions return, and compare the
+	// value received.
+	AsyncPattern bool
+	// Build the receivers of methods with the New function of their type,
+	// if the package has one.
+	UseConstructors bool
+	// Add a TestMain function to the tests of each package without one.
+	GenerateTestMain bool
+	// Check the trailing bool result of the functions without an error
+	// against a wantOk field.
+	BoolAsError bool
+	// Check the tests for goroutine leaks with goleak.
+	LeakCheck bool
+	// Check for goroutine leaks once in TestMain. Requires LeakCheck.
+	LeakCheckMain bool
+	// Break the struct literals of the test cases onto one line per field.
+	MultilineCases bool
+	// Compare the results with their Equal(T) bool method, if any.
+	UseEqualMethod bool
+	// Comment the tests with the conditions of the functions' branches.
+	CoverageHints bool
+	// Import path of the package whose T type the tests take.
+	TestingPkg string
+	// Generate tests that pass strict linters, with tt := tt in the subtests
+	// and //nolint annotations for paralleltest or tparallel.
+	LintFriendly bool
+	// Skip the tests without generated test cases until they're filled in.
+	SkipEmpty bool
+	// Add a concurrent subtest calling the function from several goroutines
+	// to each test case, for go test -race.
+	ConcurrencyCase bool
+	// Name the subtests after the arguments of their test cases.
+	AutoName bool
+	// Compare the struct results field by field.
+	FieldDiff bool
+	// Version of Go, such as 1.22, that the tests are generated for.
+	GoVersion string
+	// Set the parameters that look like paths up in a t.TempDir.
+	TempDirForPaths bool
+	// Group the tests in sections by receiver type.
+	GroupByReceiver bool
+	// Seed "success" and "error" test cases for the functions returning an error.
+	SeedErrorCase bool
+	// Only include the function whose declaration spans the 1-based Line,
+	// or the byte Offset, of the single source file, such as the one under
+	// the cursor of an editor.
+	Line   int
+	Offset int
+	// Template of the paths of the test files, such as
+	// {{.Dir}}/tests/{{.Name}}_test.go, where Dir is the directory and
+	// Name the base name without the .go extension of each source file.
+	// Defaults to <name>_test.go next to the source file.
+	OutputPath string
+	// Log the Summary of the run at its end, unless JSONOutput is set.
+	PrintSummary bool
+	// Log the paths of the source and test files relative to PathBase, or
+	// to the current directory if it's empty, rather than as given or
+	// absolute, such as those of watched files or failed writes, so that
+	// the logs don't depend on where the tree is.
+	RelativePaths bool
+	PathBase      string
+	// How much to log: Quiet only reports errors, 0 also the generated
+	// tests, and Verbose also the skipped functions and why.
+	Verbosity int
+	// Permissions of the test files created, before the umask. Defaults to
+	// 0644.
+	FileMode os.FileMode
+	// Comment at the top of new test files, such as a license header.
+	// Defaults to a "Code generated by gotests" marker, or none if "none".
+	HeaderComment string
+	HeaderFile    string // File whose content is the HeaderComment.
+	BuildTags     string // Comma-separated build tags to constrain new test files to.
+	// Names of the flags set on the command line, such as "only", whose
+	// options take precedence over the config file. If nil, the config file
+	// only sets the options left at their zero value.
+	Flags map[string]bool
+	// Directory from which to look for a .gotests.yml or .gotests.json
+	// config file, in it and its parents, and of the .gotestsignore file
+	// of the paths for Recursive to skip. Defaults to the current directory.
+	ConfigDir string
+	NoConfig  bool // Ignore config files.
+	// Keep running after generating the tests, and regenerate those of the
+	// source files written or created under the paths. Requires
+	// WriteOutput.
+	Watch bool
+	// Stops watching once closed. Defaults to watching until the process
+	// exits.
+	StopWatch <-chan struct{}
+	// Directory of a cache of the hashes of the source files, the options,
+	// the TemplateDir and HeaderFile files, the other Go files of the
+	// packages, and the test files written, by which the source files are
+	// skipped while none of them changed. Their test files then count as
+	// Unchanged. Requires WriteOutput, and is ignored with Diff or
+	// JSONOutput.
+	CacheDir string
+	// File listing source paths to process after those passed to Run, one
+	// per line, or "-" to read them from Stdin. Blank lines and the lines
+	// starting with # are skipped.
+	PathsFrom string
+	// Source read for the "-" argument, or the paths of PathsFrom "-".
+	// Defaults to os.Stdin.
+	Stdin io.Reader
+	// Destination of warnings, such as about an invalid config file.
+	// Defaults to os.Stderr.
+	Stderr io.Writer
+	// Destination of the logs, such as the generated tests and the Summary,
+	// so that they can be told apart from the generated code written to the
+	// out writer of Run. Defaults to that writer.
+	Logger io.Writer
+	// Called with each source path, the 0-based index of the path, and the
+	// total number of paths, which directories walked with Recursive expand
+	// to their files, just before the path is processed, so not for the
+	// paths skipped once a path fails. The calls are made one at a time,
+	// from the goroutines processing the paths, and so out of order with a
+	// Parallelism above 1.
+	Progress func(path string, index, total int)
+}
+
+// Errors holds the errors of every path that failed to generate tests, as
+// PathErrors in the order of the paths.
+type Errors []error
+
+// Error reports the number of paths that failed, followed by their errors
+// one per line.
+func (e Errors) Error() string {
+	paths := "paths"
+	if len(e) == 1 {
+		paths = "path"
+	}
+	s := fmt.Sprintf("Failed to generate tests for %v %v:", len(e), paths)
+	for _, err := range e {
+		s += "\n\t" + err.Error()
+	}
+	return s
+}
+
+// A PathError is the error of generating tests for Path.
+type PathError struct {
+	Path string
+	Err  error
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Path, e.Err)
+}
+
+func (e *PathError) Unwrap() error {
+	return e.Err
 }
 
 // Generates tests for the Go files defined in args with the given options.
-// Logs information and errors to out. By default outputs generated tests to
-// out unless specified by opt.
-func Run(out io.Writer, args []string, opts *Options) {
+// Logs information to opts.Logger, or out if it's nil. By default outputs generated tests to out unless
+// specified by opt. Stops at the first path that fails, unless opt.AllowError
+// is set, in which case the remaining paths are still processed, their tests
+// still output, and all failures are returned together as Errors. The paths are processed concurrently, but
+// their output is written to out in the order of args. If opt.JSONOutput is
+// set, only a JSON array of the generated tests is written to out. Options not
+// set on the command line, as recorded in opts.Flags, are taken from the
+// closest .gotests.yml or .gotests.json config file, unless opts.NoConfig is
+// set. If opts.PrintSummary is set, the Summary of the run is logged last,
+// before watching the paths for changes if opts.Watch is set.
+func Run(out io.Writer, args []string, opts *Options) error {
+	_, err := RunSummary(out, args, opts)
+	return err
+}
+
+// RunSummary runs like Run, and also returns the Summary of the paths
+// processed, even if it fails.
+func RunSummary(out io.Writer, args []string, opts *Options) (Summary, error) {
+	var sum Summary
 	if opts == nil {
 		opts = &Options{}
 	}
-	opt := parseOptions(out, opts)
-	if opt == nil {
-		return
+	opts = applyConfig(opts)
+	opt, err := parseOptions(opts)
+	if err != nil {
+		return sum, err
+	}
+	checkTestingPkg(opts)
+	ops, err := parseOutputPath(opts.OutputPath)
+	if err != nil {
+		return sum, err
+	}
+	log := out
+	if opts.Logger != nil {
+		log = opts.Logger
+	}
+	if opts.PathsFrom != "" {
+		if opts.PathsFrom == stdinArg && contains(args, stdinArg) {
+			return sum, errors.New("Cannot read both the source and the -paths-from list from stdin")
+		}
+		paths, err := manifestPaths(log, opts)
+		if err != nil {
+			return sum, err
+		}
+		args = append(args[:len(args):len(args)], paths...)
 	}
 	if len(args) == 0 {
-		fmt.Fprintln(out, "Please specify a file or directory containing the source")
-		return
+		return sum, errors.New("Please specify a file or directory containing the source")
+	}
+	if (opts.Line > 0 || opts.Offset > 0) && (len(args) != 1 || opts.Recursive) {
+		return sum, errors.New("Please specify a single source file with -line or -offset")
+	}
+	if opts.WriteOutput && contains(args, stdinArg) {
+		return sum, errors.New("Cannot write output to a test file for source read from stdin")
+	}
+	// The directories are watched rather than the files walked in them.
+	watchArgs := args
+	var ignore *ignoreList
+	if opts.Recursive {
+		dir := opts.ConfigDir
+		if dir == "" {
+			dir = "."
+		}
+		if ignore, err = readIgnore(dir); err != nil {
+			return sum, fmt.Errorf("Invalid %v: %v", ignoreName, err)
+		}
+		if args, err = walk(args, ignore); err != nil {
+			return sum, err
+		}
 	}
-	for _, path := range args {
-		generateTests(out, path, opts.WriteOutput, opt)
+	rs := make([]*pathResult, len(args))
+	for i := range rs {
+		rs[i] = &pathResult{done: make(chan struct{})}
 	}
+	cancel := make(chan struct{})
+	wg := generateAll(args, rs, opts, opt, ops, cancel)
+	defer wg.Wait()
+	defer close(cancel)
+	var errs Errors
+	var gts []*gotests.GeneratedTest
+	for i, r := range rs {
+		<-r.done
+		sum.add(r.sum)
+		if _, err := out.Write(r.out.Bytes()); err != nil {
+			return sum, err
+		}
+		if opts.Logger != nil {
+			if _, err := log.Write(r.log.Bytes()); err != nil {
+				return sum, err
+			}
+		}
+		if r.err != nil {
+			if !opts.AllowError {
+				return sum, r.err
+			}
+			errs = append(errs, &PathError{Path: opts.logPath(args[i]), Err: r.err})
+		}
+		gts = append(gts, r.gts...)
+	}
+	if opts.JSONOutput {
+		if err := writeJSON(out, gts); err != nil {
+			return sum, err
+		}
+	} else if opts.PrintSummary {
+		fmt.Fprintln(log, sum)
+	}
+	if opts.Watch {
+		if len(errs) > 0 {
+			fmt.Fprintln(log, errs)
+		}
+		return sum, watch(out, log, &sum, watchArgs, ignore, opts, opt, ops)
+	}
+	if len(errs) > 0 {
+		return sum, errs
+	}
+	return sum, nil
 }
 
-func parseOptions(out io.Writer, opt *Options) *gotests.Options {
-	if opt.OnlyFuncs == "" && opt.ExclFuncs == "" && !opt.ExportedFuncs && !opt.AllFuncs {
-		fmt.Fprintln(out, "Please specify either the -only, -excl, -export, or -all flag")
-		return nil
+// Summary counts the paths processed by a run and what became of their
+// functions.
+type Summary struct {
+	Paths       int // Source files and directories processed.
+	Tests       int // Functions with a new test.
+	Tested      int // Functions skipped because they already have a test.
+	FilteredOut int // Functions skipped because of the options.
+	Unsupported int // Functions skipped because of their signature.
+	Written     int // Test files written.
+	Unchanged   int // Test files not written since they already had the output.
+}
+
+// Skipped returns the number of functions skipped for any reason.
+func (s Summary) Skipped() int {
+	return s.Tested + s.FilteredOut + s.Unsupported
+}
+
+func (s Summary) String() string {
+	return fmt.Sprintf("Processed %v paths: generated %v tests, skipped %v functions (%v already tested, %v filtered out, %v unsupported), wrote %v files, left %v unchanged",
+		s.Paths, s.Tests, s.Skipped(), s.Tested, s.FilteredOut, s.Unsupported, s.Written, s.Unchanged)
+}
+
+func (s *Summary) add(t Summary) {
+	s.Paths += t.Paths
+	s.Tests += t.Tests
+	s.Tested += t.Tested
+	s.FilteredOut += t.FilteredOut
+	s.Unsupported += t.Unsupported
+	s.Written += t.Written
+	s.Unchanged += t.Unchanged
+}
+
+// pathResult holds the generated tests, output, and error of generating tests
+// for a path. The logs are written to out too, unless Options.Logger is set.
+// Done is closed once they are set.
+type pathResult struct {
+	gts  []*gotests.GeneratedTest
+	sum  Summary
+	out  bytes.Buffer
+	log  bytes.Buffer
+	err  error
+	done chan struct{}
+}
+
+// jsonTest is the JSON representation of a generated test file.
+type jsonTest struct {
+	Path      string   `json:"path"`
+	Functions []string `json:"functions"`
+	Source    string   `json:"source"`
+}
+
+// writeJSON writes the JSON array of gts to out.
+func writeJSON(out io.Writer, gts []*gotests.GeneratedTest) error {
+	ts := []jsonTest{}
+	for _, gt := range gts {
+		t := jsonTest{Path: gt.Path, Source: string(gt.Output)}
+		for _, fun := range gt.Functions {
+			t.Functions = append(t.Functions, fun.TestName())
+		}
+		ts = append(ts, t)
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(ts)
+}
+
+// generateAll starts opts.Parallelism workers, or GOMAXPROCS if it isn't set,
+// that generate the tests for args into the corresponding rs. Paths not yet
+// started when cancel is closed are skipped.
+func generateAll(args []string, rs []*pathResult, opts *Options, opt *gotests.Options, ops *outputPaths, cancel <-chan struct{}) *sync.WaitGroup {
+	n := opts.Parallelism
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(0)
+	}
+	paths := make(chan int)
+	go func() {
+		defer close(paths)
+		for i := range args {
+			select {
+			case paths <- i:
+			case <-cancel:
+				return
+			}
+		}
+	}()
+	var progress sync.Mutex
+	wg := &sync.WaitGroup{}
+	for w := 0; w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range paths {
+				log := &rs[i].out
+				if opts.Logger != nil {
+					log = &rs[i].log
+				}
+				if opts.Progress != nil {
+					progress.Lock()
+					opts.Progress(args[i], i, len(args))
+					progress.Unlock()
+				}
+				rs[i].gts, rs[i].err = generateTests(&rs[i].out, log, &rs[i].sum, args[i], opts, opt, ops)
+				close(rs[i].done)
+			}
+		}()
+	}
+	return wg
+}
+
+// walk replaces the directories in args with the Go source files in the
+// trees rooted at them. Vendor, testdata, and hidden directories are skipped,
+// as are the paths that ignore ignores, and symlinked directories aren't
+// followed.
+func walk(args []string, ignore *ignoreList) ([]string, error) {
+	var paths []string
+	for _, arg := range args {
+		if fi, err := os.Stat(arg); err != nil || !fi.IsDir() {
+			paths = append(paths, arg)
+			continue
+		}
+		err := filepath.WalkDir(arg, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			name := d.Name()
+			if d.IsDir() {
+				if path != arg && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || ignore.ignored(path, true)) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.Type().IsRegular() && filepath.Ext(name) == ".go" && !strings.HasSuffix(name, "_test.go") && !strings.HasPrefix(name, ".") && !ignore.ignored(path, false) {
+				paths = append(paths, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("filepath.WalkDir: %v", err)
+		}
+	}
+	return paths, nil
+}
+
+func parseOptions(opt *Options) (*gotests.Options, error) {
+	if opt.OnlyFuncs == "" && opt.ExclFuncs == "" && opt.OnlyList == "" && opt.ExclList == "" && opt.OnlyReceiver == "" && opt.ExclReceiver == "" && !opt.ExportedFuncs && !opt.AllFuncs && opt.Line == 0 && opt.Offset == 0 {
+		return nil, errors.New("Please specify either the -only, -excl, -export, or -all flag")
 	}
-	onlyRE, err := parseRegexp(opt.OnlyFuncs)
+	onlyNames, err := parseNames(opt.OnlyList)
 	if err != nil {
-		fmt.Fprintln(out, "Invalid -only regex:", err)
-		return nil
+		return nil, fmt.Errorf("Invalid -only-names list: %v", err)
 	}
-	exclRE, err := parseRegexp(opt.ExclFuncs)
+	exclNames, err := parseNames(opt.ExclList)
 	if err != nil {
-		fmt.Fprintln(out, "Invalid -excl regex:", err)
-		return nil
+		return nil, fmt.Errorf("Invalid -excl-names list: %v", err)
+	}
+	onlyRE, err := parseRegexp(union(opt.OnlyFuncs, onlyNames))
+	if err != nil {
+		return nil, fmt.Errorf("Invalid -only regex: %v", err)
+	}
+	exclRE, err := parseRegexp(union(opt.ExclFuncs, exclNames))
+	if err != nil {
+		return nil, fmt.Errorf("Invalid -excl regex: %v", err)
+	}
+	onlyRecvRE, err := parseRegexp(opt.OnlyReceiver)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid -only-recv regex: %v", err)
+	}
+	exclRecvRE, err := parseRegexp(opt.ExclReceiver)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid -excl-recv regex: %v", err)
+	}
+	if opt.ForceInternal && opt.ForceExternal {
+		return nil, errors.New("Please specify only one of the -force-internal and -force-external flags")
+	}
+	if opt.Examples && !opt.External && !opt.ForceExternal {
+		return nil, errors.New("Please specify the -external or -force-external flag with -examples, so that the examples are documented")
+	}
+	if opt.Overwrite && !opt.AllFuncs {
+		return nil, errors.New("Please specify the -all flag with -overwrite, so that the tests it replaces are regenerated")
+	}
+	if opt.Overwrite && (opt.Merge || opt.SplitFiles) {
+		return nil, errors.New("Please specify only one of the -overwrite, -merge, and -split flags")
+	}
+	if opt.CaseTimeout < 0 {
+		return nil, fmt.Errorf("Invalid -case-timeout value: %v is negative", opt.CaseTimeout)
+	}
+	if opt.GoVersion != "" && !goVersionRE.MatchString(opt.GoVersion) {
+		return nil, fmt.Errorf("Invalid -go version: %v isn't one such as 1.22", opt.GoVersion)
+	}
+	if opt.Cases < 0 {
+		return nil, fmt.Errorf("Invalid -cases number: %v is negative", opt.Cases)
+	}
+	if opt.Line < 0 {
+		return nil, fmt.Errorf("Invalid -line number: %v is negative", opt.Line)
+	}
+	if opt.Offset < 0 {
+		return nil, fmt.Errorf("Invalid -offset number: %v is negative", opt.Offset)
+	}
+	if opt.Line > 0 && opt.Offset > 0 {
+		return nil, errors.New("Please specify only one of the -line and -offset flags")
+	}
+	if opt.CaseTimeout > 0 && !opt.Subtests {
+		return nil, errors.New("Please specify only one of the -case-timeout and -nosubtests flags, since the timeout is per subtest")
+	}
+	if opt.CaseTimeout > 0 && opt.Panics {
+		return nil, errors.New("Please specify only one of the -case-timeout and -panics flags, since the panics of the calls in goroutines can't be recovered")
+	}
+	if opt.NumberCases && !opt.Subtests {
+		return nil, errors.New("Please specify only one of the -number-cases and -nosubtests flags, since the cases are numbered in the names of the subtests")
+	}
+	if opt.NumberCases && opt.TableStyle == "map" {
+		return nil, errors.New("Please specify only one of the -number-cases flag and -table map, whose keys name the test cases")
+	}
+	if opt.AutoName && !opt.Subtests {
+		return nil, errors.New("Please specify only one of the -autoname and -nosubtests flags, since the arguments name the subtests")
+	}
+	if opt.AutoName && opt.TableStyle == "map" {
+		return nil, errors.New("Please specify only one of the -autoname flag and -table map, whose keys name the test cases")
+	}
+	if opt.AutoName && opt.NumberCases {
+		return nil, errors.New("Please specify only one of the -autoname and -number-cases flags")
+	}
+	if opt.EnvSetup && opt.Parallel {
+		return nil, errors.New("Please specify only one of the -env and -parallel flags, since t.Setenv can't be used in parallel tests")
+	}
+	if opt.Watch && !opt.WriteOutput {
+		return nil, errors.New("Please specify the -w flag with -watch, so that the tests are written as the sources change")
+	}
+	if opt.CacheDir != "" && !opt.WriteOutput {
+		return nil, errors.New("Please specify the -w flag with -cache, since only the tests written are cached")
+	}
+	if opt.CaptureStdout && opt.Parallel {
+		return nil, errors.New("Please specify only one of the -stdout and -parallel flags, since parallel tests would print to each other's os.Stdout")
+	}
+	if opt.PathBase != "" && !opt.RelativePaths {
+		return nil, errors.New("Please specify the -relpaths flag with -relpaths-base")
 	}
-	return &gotests.Options{
-		Only:        onlyRE,
-		Exclude:     exclRE,
-		Exported:    opt.ExportedFuncs,
-		PrintInputs: opt.PrintInputs,
-		Subtests:    opt.Subtests,
-		AllowError:  opt.AllowError,
+	if opt.LeakCheckMain && !opt.LeakCheck {
+		return nil, errors.New("Please specify the -leakcheck flag with -leakcheck-main")
 	}
+	if opt.LeakCheck && !opt.LeakCheckMain && opt.Parallel {
+		return nil, errors.New("Please specify the -leakcheck-main flag with -leakcheck and -parallel, since the tests return before their parallel subtests run")
+	}
+	var testName *template.Template
+	if opt.TestNameTemplate != "" {
+		if testName, err = template.New("testname").Parse(opt.TestNameTemplate); err != nil {
+			return nil, fmt.Errorf("Invalid -testname template: %v", err)
+		}
+	}
+	buildTags, err := parseTags(opt.BuildTags)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid -tags list: %v", err)
+	}
+	benchSizes, err := parseSizes(opt.BenchSizes)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid -bench-sizes list: %v", err)
+	}
+	if benchSizes != nil && !opt.Benchmarks {
+		return nil, errors.New("Please specify the -bench flag with -bench-sizes")
+	}
+	switch opt.MessageFormat {
+	case "", "v", "+v", "#v":
+	default:
+		return nil, fmt.Errorf("Invalid -msgfmt value: %q. Use v, +v, or #v", opt.MessageFormat)
+	}
+	switch opt.TableStyle {
+	case "", "slice", "map":
+	default:
+		return nil, fmt.Errorf("Invalid -table value: %q. Use slice or map", opt.TableStyle)
+	}
+	switch opt.ErrorComparison {
+	case "", "bool", "is", "message":
+	default:
+		return nil, fmt.Errorf("Invalid -errcmp value: %q. Use bool, is, or message", opt.ErrorComparison)
+	}
+	switch opt.Assertion {
+	case "", "should", "testify":
+	default:
+		return nil, fmt.Errorf("Invalid -assert value: %q. Use should or testify", opt.Assertion)
+	}
+	if opt.CaseVarName != "" && !token.IsIdentifier(opt.CaseVarName) {
+		return nil, fmt.Errorf("Invalid -case-var name: %q", opt.CaseVarName)
+	}
+	if opt.ArgsStructName != "" && !token.IsIdentifier(opt.ArgsStructName) {
+		return nil, fmt.Errorf("Invalid -args-struct name: %q", opt.ArgsStructName)
+	}
+	if err := checkFileMode(opt.FileMode); err != nil {
+		return nil, fmt.Errorf("Invalid -perm mode: %v", err)
+	}
+	header, err := parseHeader(opt.HeaderComment, opt.HeaderFile)
+	if err != nil {
+		return nil, err
+	}
+	var params map[string]interface{}
+	if opt.TemplateParams != "" {
+		if err := json.Unmarshal([]byte(opt.TemplateParams), &params); err != nil {
+			return nil, fmt.Errorf("Invalid -template-params JSON: %v", err)
+		}
+	}
+	// The other options are those of the same names.
+	o := &gotests.Options{}
+	fields.Copy(o, opt)
+	o.Only = onlyRE
+	o.Exclude = exclRE
+	o.OnlyReceiver = onlyRecvRE
+	o.ExclReceiver = exclRecvRE
+	o.Exported = opt.ExportedFuncs
+	o.BenchSizes = benchSizes
+	o.TestNameTemplate = testName
+	o.TemplateParams = params
+	o.HeaderComment = header
+	o.BuildTags = buildTags
+	return o, nil
+}
+
+// The header comment of test files by default, which marks them as
+// generated.
+const generatedHeader = "// Code generated by gotests. DO NOT EDIT."
+
+// parseHeader returns the header comment of test files, read from the file
+// at path if set, or else the comment. It must only consist of comments.
+func parseHeader(comment, path string) (string, error) {
+	if path != "" {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("Invalid -header-file: %v", err)
+		}
+		comment = string(b)
+	}
+	switch comment {
+	case "":
+		return generatedHeader, nil
+	case "none":
+		return "", nil
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "", comment+"\n\npackage p\n", parser.PackageClauseOnly); err != nil {
+		return "", fmt.Errorf("Invalid -header comment %q: it must only consist of comments", comment)
+	}
+	return comment, nil
 }
 
 func parseRegexp(s string) (*regexp.Regexp, error) {
@@ -83,32 +748,472 @@ func parseRegexp(s string) (*regexp.Regexp, error) {
 	return re, nil
 }
 
-func generateTests(out io.Writer, path string, writeOutput bool, opt *gotests.Options) {
-	gts, err := gotests.GenerateTests(path, opt)
+// parseNames returns a regexp string matching exactly the comma-separated
+// names in s, or "" if s is empty.
+func parseNames(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	var names []string
+	for _, n := range strings.Split(s, ",") {
+		n = strings.TrimSpace(n)
+		if n == "" {
+			return "", fmt.Errorf("empty name in %q", s)
+		}
+		names = append(names, regexp.QuoteMeta(n))
+	}
+	return "^(?:" + strings.Join(names, "|") + ")$", nil
+}
+
+// parseSizes returns the comma-separated positive integers in s, or nil if s
+// is empty.
+func parseSizes(s string) ([]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var sizes []int
+	for _, f := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(f))
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("%q is not a positive integer", f)
+		}
+		sizes = append(sizes, n)
+	}
+	return sizes, nil
+}
+
+// parseTags returns the comma-separated build tags in s, or nil if s is
+// empty.
+func parseTags(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var tags []string
+	for _, f := range strings.Split(s, ",") {
+		tag := strings.TrimSpace(f)
+		x, err := constraint.Parse("//go:build " + tag)
+		if _, ok := x.(*constraint.TagExpr); err != nil || !ok {
+			return nil, fmt.Errorf("%q is not a build tag", f)
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// union returns a regexp string matching either of the regexp strings a and
+// b, either of which may be empty.
+func union(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "":
+		return a
+	}
+	return "(?:" + a + ")|" + b
+}
+
+// ParseFileMode parses the octal permissions of test files, such as 0664.
+func ParseFileMode(s string) (os.FileMode, error) {
+	n, err := strconv.ParseUint(s, 8, 32)
 	if err != nil {
-		fmt.Fprintln(out, err.Error())
+		return 0, fmt.Errorf("%q is not an octal mode", s)
+	}
+	m := os.FileMode(n)
+	if err := checkFileMode(m); err != nil {
+		return 0, err
+	}
+	return m, nil
+}
+
+// checkFileMode returns an error unless m is 0, meaning the default, or
+// permission bits letting their owner read and write the test files.
+func checkFileMode(m os.FileMode) error {
+	if m == 0 {
+		return nil
+	}
+	if m&^os.ModePerm != 0 {
+		return fmt.Errorf("%#o has bits other than permissions", uint32(m))
+	}
+	if m&0600 != 0600 {
+		return fmt.Errorf("%#o doesn't let the owner read and write the test files", uint32(m))
+	}
+	return nil
+}
+
+// checkTestingPkg warns on opts.Stderr if the T type of opts.TestingPkg
+// lacks a method of testing.T that the tests call, or can't be checked for
+// them, since the tests wouldn't compile.
+func checkTestingPkg(opts *Options) {
+	if opts.TestingPkg == "" || opts.TestingPkg == "testing" {
 		return
 	}
-	if len(gts) == 0 {
-		fmt.Fprintln(out, "No tests generated for", path)
+	stderr := opts.Stderr
+	if stderr == nil {
+		stderr = os.Stderr
+	}
+	pkg, err := importer.ForCompiler(token.NewFileSet(), "source", nil).Import(opts.TestingPkg)
+	if err != nil {
+		fmt.Fprintf(stderr, "Warning: can't check the T type of %v: %v\n", opts.TestingPkg, err)
 		return
 	}
+	t, ok := pkg.Scope().Lookup("T").(*types.TypeName)
+	if !ok {
+		fmt.Fprintf(stderr, "Warning: %v has no T type for the tests to take\n", opts.TestingPkg)
+		return
+	}
+	methods := []string{"Errorf", "Fatal"}
+	if opts.Subtests {
+		methods = append(methods, "Run")
+	}
+	if opts.Parallel {
+		methods = append(methods, "Parallel")
+	}
+	ms := types.NewMethodSet(types.NewPointer(t.Type()))
+	for _, m := range methods {
+		if ms.Lookup(pkg, m) == nil {
+			fmt.Fprintf(stderr, "Warning: %v.T has no %v method, which the tests call\n", opts.TestingPkg, m)
+		}
+	}
+}
+
+func generateTests(out, log io.Writer, sum *Summary, path string, opts *Options, opt *gotests.Options, ops *outputPaths) ([]*gotests.GeneratedTest, error) {
+	writeOutput := opts.WriteOutput
+	perm := opts.FileMode
+	if perm == 0 {
+		perm = newFilePerm
+	}
+	sum.Paths++
+	c := newCache(opts)
+	var src string
+	if c != nil && path != stdinArg {
+		var n int
+		if src, n = c.check(path); n >= 0 {
+			sum.Unchanged += n
+			if opts.Verbosity >= Verbose {
+				fmt.Fprintln(log, "Cached", opts.logPath(path))
+			}
+			return nil, nil
+		}
+	}
+	var skips skipLog
+	o := *opt
+	o.Skipped = skips.add
+	o.SkippedGenerated = func(srcPath string) { skips.addGenerated(path, srcPath) }
+	opt = &o
+	var gts []*gotests.GeneratedTest
+	var err error
+	if path == stdinArg {
+		gts, err = generateStdinTests(opts.Stdin, opt)
+	} else {
+		gts, err = gotests.GenerateTests(path, opt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if opts.JSONOutput {
+		// The tests are only written out as JSON, which the logs mustn't
+		// interleave.
+		out = ioutil.Discard
+		if opts.Logger == nil {
+			log = ioutil.Discard
+		}
+	}
+	skips.count(sum)
+	if opts.Verbosity > Quiet {
+		skips.writeGenerated(log, opts)
+	}
+	if opts.Verbosity >= Verbose {
+		skips.write(log)
+	} else if opts.Verbosity > Quiet {
+		skips.warn(log)
+	}
+	if len(gts) == 0 {
+		if opts.Verbosity > Quiet {
+			fmt.Fprintln(log, "No tests generated for", opts.logPath(path))
+		}
+		return nil, storeCache(c, path, src, nil)
+	}
+	if ops != nil {
+		for _, t := range gts {
+			if t.Path, err = ops.path(t.Path); err != nil {
+				return nil, err
+			}
+		}
+	}
 	for _, t := range gts {
-		outputTest(out, t, writeOutput)
+		sum.Tests += len(t.Functions)
+		if opts.Diff {
+			if err := outputDiff(out, t); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		written, err := outputTest(out, log, t, writeOutput, perm, opts)
+		if err != nil {
+			return nil, relativeError(err, opts)
+		}
+		if written {
+			sum.Written++
+		} else if writeOutput {
+			sum.Unchanged++
+		}
+	}
+	return gts, storeCache(c, path, src, gts)
+}
+
+// storeCache records in c, if any, the tests gts generated for the source
+// file at path, whose hash is src, or nothing if src is empty.
+func storeCache(c *cache, path, src string, gts []*gotests.GeneratedTest) error {
+	if c == nil || src == "" {
+		return nil
 	}
+	if err := c.store(path, src, gts); err != nil {
+		return fmt.Errorf("Cannot write the -cache entry of %v: %v", path, err)
+	}
+	return nil
+}
+
+// skipLog collects the functions skipped while generating the tests of a
+// path, which may be reported concurrently for the files of a directory.
+type skipLog struct {
+	mu    sync.Mutex
+	skips []skip
+	// The generated source files skipped.
+	generated []string
+}
+
+type skip struct {
+	testPath string
+	name     string
+	reason   gotests.SkipReason
 }
 
-func outputTest(out io.Writer, t *gotests.GeneratedTest, writeOutput bool) {
+func (l *skipLog) add(testPath string, f *models.Function, reason gotests.SkipReason) {
+	name := f.Name
+	if f.Receiver != nil {
+		name = f.Receiver.Type.TypeName() + "." + name
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.skips = append(l.skips, skip{testPath, name, reason})
+}
+
+// addGenerated records the generated source file at srcPath, skipped while
+// generating the tests of path.
+func (l *skipLog) addGenerated(path, srcPath string) {
+	if name := filepath.Base(srcPath); name != filepath.Base(path) {
+		srcPath = filepath.Join(path, name)
+	} else {
+		srcPath = path
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.generated = append(l.generated, srcPath)
+}
+
+// count adds the skipped functions to sum by reason.
+func (l *skipLog) count(sum *Summary) {
+	for _, s := range l.skips {
+		switch s.reason {
+		case gotests.Tested:
+			sum.Tested++
+		case gotests.FilteredOut:
+			sum.FilteredOut++
+		case gotests.Unsupported, gotests.Unexposable:
+			sum.Unsupported++
+		}
+	}
+}
+
+// write logs the skipped functions to out, grouped by test file in the
+// order of their source.
+func (l *skipLog) write(out io.Writer) {
+	sort.SliceStable(l.skips, func(i, j int) bool {
+		return l.skips[i].testPath < l.skips[j].testPath
+	})
+	for _, s := range l.skips {
+		fmt.Fprintf(out, "Skipped %v: %v\n", s.name, s.reason)
+	}
+}
+
+// warn logs the functions skipped for their unexported types, which
+// external tests can't reference, as warnings.
+func (l *skipLog) warn(out io.Writer) {
+	sort.SliceStable(l.skips, func(i, j int) bool {
+		return l.skips[i].testPath < l.skips[j].testPath
+	})
+	for _, s := range l.skips {
+		if s.reason == gotests.Unexposable {
+			fmt.Fprintf(out, "Warning: skipped %v: %v\n", s.name, s.reason)
+		}
+	}
+}
+
+// writeGenerated logs the generated source files skipped.
+func (l *skipLog) writeGenerated(out io.Writer, opts *Options) {
+	sort.Strings(l.generated)
+	for _, src := range l.generated {
+		fmt.Fprintln(out, "Skipped generated file", opts.logPath(src))
+	}
+}
+
+// outputPaths maps the default paths of test files to the ones of the
+// OutputPath template, ensuring that no two test files share a path.
+type outputPaths struct {
+	tmpl *template.Template
+	mu   sync.Mutex
+	// The default test paths by the paths they're mapped to.
+	claimed map[string]string
+}
+
+// parseOutputPath returns the outputPaths of the template s, or nil if s is
+// empty.
+func parseOutputPath(s string) (*outputPaths, error) {
+	if s == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New("output").Option("missingkey=error").Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid -o template: %v", err)
+	}
+	return &outputPaths{tmpl: tmpl, claimed: make(map[string]string)}, nil
+}
+
+// path returns the path that the test file at testPath is written to.
+func (o *outputPaths) path(testPath string) (string, error) {
+	b := &bytes.Buffer{}
+	err := o.tmpl.Execute(b, struct{ Dir, Name string }{
+		Dir:  filepath.Dir(testPath),
+		Name: strings.TrimSuffix(filepath.Base(testPath), "_test.go"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("-o template: %v", err)
+	}
+	p := filepath.Clean(b.String())
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if prev, ok := o.claimed[p]; ok && prev != testPath {
+		src := func(testPath string) string { return strings.TrimSuffix(testPath, "_test.go") + ".go" }
+		return "", fmt.Errorf("The tests of %v and %v would both be written to %v", src(prev), src(testPath), p)
+	}
+	o.claimed[p] = testPath
+	return p, nil
+}
+
+// generateStdinTests generates tests for the Go source read from in, or
+// os.Stdin if in is nil. The source is named after its package clause, so
+// that its tests' path is <package>_test.go.
+func generateStdinTests(in io.Reader, opt *gotests.Options) ([]*gotests.GeneratedTest, error) {
+	if in == nil {
+		in = os.Stdin
+	}
+	src, err := ioutil.ReadAll(in)
+	if err != nil {
+		return nil, fmt.Errorf("ioutil.ReadAll stdin: %v", err)
+	}
+	filename := "stdin.go"
+	if f, err := parser.ParseFile(token.NewFileSet(), "", src, parser.PackageClauseOnly); err == nil {
+		filename = f.Name.Name + ".go"
+	}
+	return gotests.GenerateTestsFromSource(filename, src, opt)
+}
+
+func contains(ss []string, s string) bool {
+	for _, e := range ss {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// outputTest writes t to its test file if writeOutput is set, or else to out,
+// and logs its tests to log. It reports whether it wrote the file, which it
+// leaves untouched, without logging, if it already has the output.
+func outputTest(out, log io.Writer, t *gotests.GeneratedTest, writeOutput bool, perm os.FileMode, opts *Options) (bool, error) {
 	if writeOutput {
-		if err := ioutil.WriteFile(t.Path, t.Output, newFilePerm); err != nil {
-			fmt.Fprintln(out, err)
-			return
+		if b, err := ioutil.ReadFile(t.Path); err == nil && bytes.Equal(b, t.Output) {
+			if opts.Verbosity >= Verbose {
+				fmt.Fprintln(log, "Unchanged", opts.logPath(t.Path))
+			}
+			return false, nil
+		}
+		if err := os.MkdirAll(filepath.Dir(t.Path), newDirPerm); err != nil {
+			return false, err
+		}
+		if err := ioutil.WriteFile(t.Path, t.Output, perm); err != nil {
+			return false, err
 		}
 	}
-	for _, t := range t.Functions {
-		fmt.Fprintln(out, "Generated", t.TestName())
+	if opts.Verbosity > Quiet {
+		for _, t := range t.Functions {
+			fmt.Fprintln(log, "Generated", t.TestName())
+		}
 	}
 	if !writeOutput {
-		out.Write(t.Output)
+		if _, err := out.Write(t.Output); err != nil {
+			return false, err
+		}
+	}
+	return writeOutput, nil
+}
+
+// logPath returns the path p as it's logged: relative to opts.PathBase, or
+// the current directory, with opts.RelativePaths, and as is otherwise or if
+// it can't be made relative.
+func (opts *Options) logPath(p string) string {
+	if !opts.RelativePaths || p == stdinArg {
+		return p
+	}
+	base := opts.PathBase
+	if base == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return p
+		}
+		base = wd
+	}
+	base, err := filepath.Abs(base)
+	if err != nil {
+		return p
+	}
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return p
+	}
+	rel, err := filepath.Rel(base, abs)
+	if err != nil {
+		return p
+	}
+	return rel
+}
+
+// relativeError returns err with the path of an *os.PathError, such as
+// that of a test file which failed to be written, as it's logged.
+func relativeError(err error, opts *Options) error {
+	pe, ok := err.(*os.PathError)
+	if !ok || !opts.RelativePaths {
+		return err
+	}
+	return &os.PathError{Op: pe.Op, Path: opts.logPath(pe.Path), Err: pe.Err}
+}
+
+// outputDiff prints the unified diff between t's existing test file, if any,
+// and its generated output.
+func outputDiff(out io.Writer, t *gotests.GeneratedTest) error {
+	name := t.Path
+	if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, t.Path); err == nil && !strings.HasPrefix(rel, "..") {
+			name = filepath.ToSlash(rel)
+		}
+	}
+	oldName := name
+	old, err := ioutil.ReadFile(t.Path)
+	if os.IsNotExist(err) {
+		oldName = ""
+	} else if err != nil {
+		return err
 	}
+	_, err = out.Write(diff.Unified(oldName, name, old, t.Output))
+	return err
 }
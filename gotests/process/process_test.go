@@ -2,59 +2,1459 @@ package process
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/cweill/gotests"
 )
 
 func TestRun(t *testing.T) {
 	tests := []struct {
-		name string
-		args []string
-		opts *Options
-		want string
+		name    string
+		args    []string
+		opts    *Options
+		want    string
+		wantErr string
 	}{
 		// TODO: Add test cases.
 		{
-			name: "Nil options and nil args",
-			args: nil,
-			opts: nil,
-			want: "Please specify either the -only, -excl, -export, or -all flag\n",
+			name:    "Nil options and nil args",
+			args:    nil,
+			opts:    nil,
+			wantErr: "Please specify either the -only, -excl, -export, or -all flag",
 		}, {
-			name: "Nil options",
-			args: []string{"testdata/foobar.go"},
-			opts: nil,
-			want: "Please specify either the -only, -excl, -export, or -all flag\n",
+			name:    "Nil options",
+			args:    []string{"testdata/foobar.go"},
+			opts:    nil,
+			wantErr: "Please specify either the -only, -excl, -export, or -all flag",
 		}, {
-			name: "Empty options",
-			args: []string{"testdata/foobar.go"},
-			opts: &Options{},
-			want: "Please specify either the -only, -excl, -export, or -all flag\n",
+			name:    "Empty options",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{},
+			wantErr: "Please specify either the -only, -excl, -export, or -all flag",
 		}, {
-			name: "Non-empty options with no args",
-			args: []string{},
-			opts: &Options{AllFuncs: true},
-			want: "Please specify a file or directory containing the source\n",
+			name:    "Non-empty options with no args",
+			args:    []string{},
+			opts:    &Options{AllFuncs: true},
+			wantErr: "Please specify a file or directory containing the source",
 		}, {
 			name: "OnlyFuncs option w/ no matches",
 			args: []string{"testdata/foobar.go"},
 			opts: &Options{OnlyFuncs: "FooBar"},
 			want: "No tests generated for testdata/foobar.go\n",
 		}, {
-			name: "Invalid OnlyFuncs option",
+			name: "Quiet OnlyFuncs option w/ no matches",
+			args: []string{"testdata/foobar.go"},
+			opts: &Options{OnlyFuncs: "FooBar", Verbosity: Quiet},
+			want: "",
+		}, {
+			name: "Verbose OnlyFuncs option w/ no matches",
+			args: []string{"testdata/foobar.go"},
+			opts: &Options{OnlyFuncs: "FooBar", Verbosity: Verbose},
+			want: "Skipped Foo.Foo: filtered out\nSkipped Bar.bar: filtered out\nNo tests generated for testdata/foobar.go\n",
+		}, {
+			name:    "Invalid OnlyFuncs option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{OnlyFuncs: "??"},
+			wantErr: "Invalid -only regex: error parsing regexp: missing argument to repetition operator: `??`",
+		}, {
+			name:    "Invalid ExclFuncs option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{ExclFuncs: "??"},
+			wantErr: "Invalid -excl regex: error parsing regexp: missing argument to repetition operator: `??`",
+		}, {
+			name:    "Invalid OnlyReceiver option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{OnlyReceiver: "??"},
+			wantErr: "Invalid -only-recv regex: error parsing regexp: missing argument to repetition operator: `??`",
+		}, {
+			name:    "Invalid ExclReceiver option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{ExclReceiver: "??"},
+			wantErr: "Invalid -excl-recv regex: error parsing regexp: missing argument to repetition operator: `??`",
+		}, {
+			name: "Verbose OnlyReceiver option w/ no matches",
 			args: []string{"testdata/foobar.go"},
-			opts: &Options{OnlyFuncs: "??"},
-			want: "Invalid -only regex: error parsing regexp: missing argument to repetition operator: `??`\n",
+			opts: &Options{OnlyReceiver: "Baz", Verbosity: Verbose},
+			want: "Skipped Foo.Foo: filtered out\nSkipped Bar.bar: filtered out\nNo tests generated for testdata/foobar.go\n",
+		}, {
+			name:    "Empty name in OnlyList option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{OnlyList: "Foo.Foo,,Bar.bar"},
+			wantErr: `Invalid -only-names list: empty name in "Foo.Foo,,Bar.bar"`,
+		}, {
+			name:    "Empty name in ExclList option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, ExclList: "Foo.Foo, "},
+			wantErr: `Invalid -excl-names list: empty name in "Foo.Foo, "`,
+		}, {
+			name: "OnlyList option with no exact matches",
+			args: []string{"testdata/foobar.go"},
+			opts: &Options{OnlyList: "Fo,Foo.Bar"},
+			want: "No tests generated for testdata/foobar.go\n",
+		}, {
+			name:    "Examples without External",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, Examples: true},
+			wantErr: "Please specify the -external or -force-external flag with -examples, so that the examples are documented",
+		}, {
+			name:    "ForceInternal with ForceExternal",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, ForceInternal: true, ForceExternal: true},
+			wantErr: "Please specify only one of the -force-internal and -force-external flags",
+		}, {
+			name:    "CaseTimeout without Subtests",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, CaseTimeout: time.Second},
+			wantErr: "Please specify only one of the -case-timeout and -nosubtests flags, since the timeout is per subtest",
+		}, {
+			name:    "CaseTimeout with Panics",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, Subtests: true, Panics: true, CaseTimeout: time.Second},
+			wantErr: "Please specify only one of the -case-timeout and -panics flags, since the panics of the calls in goroutines can't be recovered",
+		}, {
+			name:    "Negative CaseTimeout",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, Subtests: true, CaseTimeout: -time.Second},
+			wantErr: "Invalid -case-timeout value: -1s is negative",
+		}, {
+			name:    "Invalid GoVersion option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, GoVersion: "22"},
+			wantErr: "Invalid -go version: 22 isn't one such as 1.22",
+		}, {
+			name:    "NumberCases without Subtests",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, NumberCases: true},
+			wantErr: "Please specify only one of the -number-cases and -nosubtests flags, since the cases are numbered in the names of the subtests",
+		}, {
+			name:    "NumberCases with a map TableStyle",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, Subtests: true, NumberCases: true, TableStyle: "map"},
+			wantErr: "Please specify only one of the -number-cases flag and -table map, whose keys name the test cases",
+		}, {
+			name:    "AutoName without Subtests",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, AutoName: true},
+			wantErr: "Please specify only one of the -autoname and -nosubtests flags, since the arguments name the subtests",
+		}, {
+			name:    "AutoName with a map TableStyle",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, Subtests: true, AutoName: true, TableStyle: "map"},
+			wantErr: "Please specify only one of the -autoname flag and -table map, whose keys name the test cases",
+		}, {
+			name:    "AutoName with NumberCases",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, Subtests: true, AutoName: true, NumberCases: true},
+			wantErr: "Please specify only one of the -autoname and -number-cases flags",
+		}, {
+			name:    "Invalid ErrorComparison option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, ErrorComparison: "equal"},
+			wantErr: `Invalid -errcmp value: "equal". Use bool, is, or message`,
+		}, {
+			name:    "Invalid Assertion option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, Assertion: "assert"},
+			wantErr: `Invalid -assert value: "assert". Use should or testify`,
+		}, {
+			name:    "Invalid CaseVarName option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, CaseVarName: "test cases"},
+			wantErr: `Invalid -case-var name: "test cases"`,
+		}, {
+			name:    "Invalid ArgsStructName option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, ArgsStructName: "1args"},
+			wantErr: `Invalid -args-struct name: "1args"`,
+		}, {
+			name:    "Overwrite without AllFuncs",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{OnlyFuncs: "Foo", Overwrite: true},
+			wantErr: "Please specify the -all flag with -overwrite",
+		}, {
+			name:    "Overwrite with Merge",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, Overwrite: true, Merge: true},
+			wantErr: "Please specify only one of the -overwrite, -merge, and -split flags",
+		}, {
+			name:    "Invalid TableStyle option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, TableStyle: "array"},
+			wantErr: `Invalid -table value: "array"`,
+		}, {
+			name:    "Invalid MessageFormat option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, MessageFormat: "q"},
+			wantErr: `Invalid -msgfmt value: "q"`,
+		}, {
+			name:    "Invalid BenchSizes option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, Benchmarks: true, BenchSizes: "10,0"},
+			wantErr: `Invalid -bench-sizes list: "0" is not a positive integer`,
+		}, {
+			name:    "Invalid BuildTags option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, BuildTags: "integration,!e2e"},
+			wantErr: `Invalid -tags list: "!e2e" is not a build tag`,
+		}, {
+			name:    "Negative Cases option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, Cases: -1},
+			wantErr: "Invalid -cases number: -1 is negative",
+		}, {
+			name:    "CaptureStdout option with Parallel",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, CaptureStdout: true, Parallel: true},
+			wantErr: "Please specify only one of the -stdout and -parallel flags",
+		}, {
+			name:    "LeakCheckMain option without LeakCheck",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, LeakCheckMain: true},
+			wantErr: "Please specify the -leakcheck flag with -leakcheck-main",
+		}, {
+			name:    "LeakCheck option with Parallel",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, LeakCheck: true, Parallel: true},
+			wantErr: "Please specify the -leakcheck-main flag with -leakcheck and -parallel",
+		}, {
+			name:    "Line option without the other filters",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{Line: 1},
+			wantErr: "no function is declared at line 1 of",
+		}, {
+			name:    "Line option with Offset",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{Line: 1, Offset: 1},
+			wantErr: "Please specify only one of the -line and -offset flags",
+		}, {
+			name:    "Line option with several paths",
+			args:    []string{"testdata/foobar.go", "testdata/foobar.go"},
+			opts:    &Options{Line: 1},
+			wantErr: "Please specify a single source file with -line or -offset",
+		}, {
+			name:    "Watch option without WriteOutput",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, Watch: true},
+			wantErr: "Please specify the -w flag with -watch",
+		}, {
+			name:    "CacheDir option without WriteOutput",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, CacheDir: "testdata/cache"},
+			wantErr: "Please specify the -w flag with -cache",
+		}, {
+			name:    "EnvSetup option with Parallel",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, EnvSetup: true, Parallel: true},
+			wantErr: "Please specify only one of the -env and -parallel flags",
 		}, {
-			name: "Invalid ExclFuncs option",
+			name:    "Invalid TestNameTemplate option",
+			args:    []string{"testdata/parse.go"},
+			opts:    &Options{AllFuncs: true, TestNameTemplate: "Test{{.Name"},
+			wantErr: "Invalid -testname template: ",
+		}, {
+			name:    "TestNameTemplate option naming invalid tests",
+			args:    []string{"testdata/parse.go"},
+			opts:    &Options{AllFuncs: true, TestNameTemplate: "Test {{.Name}}"},
+			wantErr: `-testname template: "Test Parse" is not the name of a test function`,
+		}, {
+			name:    "TestNameTemplate option naming tests alike",
+			args:    []string{"testdata/parse.go"},
+			opts:    &Options{AllFuncs: true, TestNameTemplate: "TestParse"},
+			wantErr: "-testname template: the tests of Parse and parseInt would both be named TestParse",
+		}, {
+			name:    "BenchSizes option without Benchmarks",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, BenchSizes: "10"},
+			wantErr: "Please specify the -bench flag with -bench-sizes",
+		}, {
+			name:    "Invalid OutputPath option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, OutputPath: "{{.Dir"},
+			wantErr: "Invalid -o template: ",
+		}, {
+			name:    "OutputPath option with an unknown field",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, OutputPath: "{{.Package}}_test.go"},
+			wantErr: "-o template: ",
+		}, {
+			name:    "Invalid TemplateParams option",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, TemplateParams: "{timeout: 5s}"},
+			wantErr: "Invalid -template-params JSON: ",
+		}, {
+			name: "Source from stdin",
+			args: []string{"-"},
+			opts: &Options{OnlyFuncs: "FooBar", Stdin: strings.NewReader("package stdin\n\nfunc Foo() {}\n")},
+			want: "No tests generated for -\n",
+		}, {
+			name:    "Invalid source from stdin",
+			args:    []string{"-"},
+			opts:    &Options{AllFuncs: true, Stdin: strings.NewReader("package stdin\n\nfunc Foo( {}\n")},
+			wantErr: "Parser.ParseSource: ",
+		}, {
+			name:    "Source from stdin with WriteOutput",
+			args:    []string{"-"},
+			opts:    &Options{AllFuncs: true, WriteOutput: true, Stdin: strings.NewReader("package stdin\n")},
+			wantErr: "Cannot write output to a test file for source read from stdin",
+		}, {
+			name: "JSONOutput option w/ no matches",
 			args: []string{"testdata/foobar.go"},
-			opts: &Options{ExclFuncs: "??"},
-			want: "Invalid -excl regex: error parsing regexp: missing argument to repetition operator: `??`\n",
+			opts: &Options{OnlyFuncs: "FooBar", JSONOutput: true},
+			want: "[]\n",
+		}, {
+			name:    "Nonexistent file",
+			args:    []string{"testdata/nonexistent.go", "testdata/foobar.go"},
+			opts:    &Options{OnlyFuncs: "FooBar"},
+			wantErr: "Parser.Parse source file: ",
+		}, {
+			name:    "Nonexistent file with AllowError",
+			args:    []string{"testdata/nonexistent.go", "testdata/foobar.go"},
+			opts:    &Options{OnlyFuncs: "FooBar", AllowError: true},
+			want:    "No tests generated for testdata/foobar.go\n",
+			wantErr: "Failed to generate tests for 1 path:\n\ttestdata/nonexistent.go: Parser.Parse source file: ",
+		}, {
+			name:    "Nonexistent files with AllowError",
+			args:    []string{"testdata/nonexistent.go", "testdata/foobar.go", "testdata/nonexistent2.go"},
+			opts:    &Options{OnlyFuncs: "FooBar", AllowError: true},
+			want:    "No tests generated for testdata/foobar.go\n",
+			wantErr: "Failed to generate tests for 2 paths:\n\ttestdata/nonexistent.go: Parser.Parse source file: ",
+		}, {
+			name: "Recursive directory",
+			args: []string{"testdata/tree"},
+			opts: &Options{OnlyFuncs: "FooBar", Recursive: true},
+			want: "No tests generated for testdata/tree/a.go\n" +
+				"No tests generated for testdata/tree/sub/b.go\n",
+		}, {
+			name: "Parallel paths",
+			args: []string{"testdata/tree/a.go", "testdata/foobar.go", "testdata/tree/sub/b.go"},
+			opts: &Options{OnlyFuncs: "FooBar", Parallelism: 2},
+			want: "No tests generated for testdata/tree/a.go\n" +
+				"No tests generated for testdata/foobar.go\n" +
+				"No tests generated for testdata/tree/sub/b.go\n",
+		}, {
+			name:    "Parallel paths stop at the first failure",
+			args:    []string{"testdata/tree/a.go", "testdata/nonexistent.go", "testdata/foobar.go"},
+			opts:    &Options{OnlyFuncs: "FooBar", Parallelism: 3},
+			want:    "No tests generated for testdata/tree/a.go\n",
+			wantErr: "Parser.Parse source file: ",
+		}, {
+			name: "RelativePaths option with PathBase",
+			args: []string{"testdata/tree/a.go", "testdata/foobar.go"},
+			opts: &Options{OnlyFuncs: "FooBar", RelativePaths: true, PathBase: "testdata/tree"},
+			want: "No tests generated for a.go\n" +
+				"No tests generated for ../foobar.go\n",
+		}, {
+			name:    "PathBase option without RelativePaths",
+			args:    []string{"testdata/foobar.go"},
+			opts:    &Options{AllFuncs: true, PathBase: "testdata"},
+			wantErr: "Please specify the -relpaths flag with -relpaths-base",
 		},
 	}
 	for _, tt := range tests {
 		out := &bytes.Buffer{}
-		Run(out, tt.args, tt.opts)
+		err := Run(out, tt.args, tt.opts)
+		if (err != nil) != (tt.wantErr != "") || err != nil && !strings.HasPrefix(err.Error(), tt.wantErr) {
+			t.Errorf("%q. Run() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
 		if got := out.String(); got != tt.want {
 			t.Errorf("%q. Run() =\n%v, want\n%v", tt.name, got, tt.want)
 		}
 	}
 }
+
+func TestRunJSONOutput(t *testing.T) {
+	out := &bytes.Buffer{}
+	if err := Run(out, []string{"testdata/tree/sub/b.go", "testdata/foobar.go"}, &Options{AllFuncs: true, JSONOutput: true}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	var got []struct {
+		Path      string   `json:"path"`
+		Functions []string `json:"functions"`
+		Source    string   `json:"source"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal(%q) error = %v", out, err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Run() returned %v tests, want 2", len(got))
+	}
+	if want := "testdata/tree/sub/b_test.go"; !strings.HasSuffix(got[0].Path, want) {
+		t.Errorf("Run() path = %v, want suffix %v", got[0].Path, want)
+	}
+	if want := []string{"TestB"}; !reflect.DeepEqual(got[0].Functions, want) {
+		t.Errorf("Run() functions = %v, want %v", got[0].Functions, want)
+	}
+	if want := "func TestB(t *testing.T) {"; !strings.Contains(got[0].Source, want) {
+		t.Errorf("Run() source = %v, want it to contain %v", got[0].Source, want)
+	}
+	if want := []string{"TestFoo_Foo", "TestBar_bar"}; !reflect.DeepEqual(got[1].Functions, want) {
+		t.Errorf("Run() functions = %v, want %v", got[1].Functions, want)
+	}
+}
+
+func TestRunDiff(t *testing.T) {
+	out := &bytes.Buffer{}
+	if err := Run(out, []string{"testdata/tree/sub/b.go"}, &Options{AllFuncs: true, Diff: true, WriteOutput: true}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if _, err := os.Stat("testdata/tree/sub/b_test.go"); !os.IsNotExist(err) {
+		os.Remove("testdata/tree/sub/b_test.go")
+		t.Errorf("Run() wrote testdata/tree/sub/b_test.go, want only a diff")
+	}
+	lines := strings.SplitAfter(out.String(), "\n")
+	if want := "--- /dev/null\n+++ b/testdata/tree/sub/b_test.go\n"; !strings.HasPrefix(out.String(), want) {
+		t.Fatalf("Run() =\n%v, want prefix\n%v", out, want)
+	}
+	if want := fmt.Sprintf("@@ -0,0 +1,%v @@\n", len(lines)-4); lines[2] != want {
+		t.Errorf("Run() hunk header = %q, want %q", lines[2], want)
+	}
+	for _, l := range lines[3 : len(lines)-1] {
+		if !strings.HasPrefix(l, "+") {
+			t.Errorf("Run() diff line = %q, want an addition", l)
+		}
+	}
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "p.go")
+	if err := ioutil.WriteFile(src, []byte("package p\n\nfunc F() int { return 0 }\n\nfunc G() int { return 1 }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	existing := "package p\n\nimport \"testing\"\n\nfunc TestF(t *testing.T) {}\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "p_test.go"), []byte(existing), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	if err := Run(out, []string{src}, &Options{AllFuncs: true, Merge: true, Diff: true}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if want := "+func TestG(t *testing.T) {\n"; !strings.Contains(out.String(), want) {
+		t.Errorf("Run() =\n%v, want it to contain %v", out, want)
+	}
+	if want := "--- a/"; !strings.HasPrefix(out.String(), want) || strings.Contains(out.String(), "-func TestF") {
+		t.Errorf("Run() =\n%v, want a diff keeping TestF", out)
+	}
+	if got, err := ioutil.ReadFile(filepath.Join(dir, "p_test.go")); err != nil || string(got) != existing {
+		t.Errorf("Run() changed p_test.go to %q, %v", got, err)
+	}
+}
+
+func TestRunVerbose(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "p.go")
+	if err := ioutil.WriteFile(src, []byte("package p\n\nfunc F() int { return 0 }\n\nfunc G() int { return 1 }\n\nfunc init() {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "p_test.go"), []byte("package p\n\nimport \"testing\"\n\nfunc TestF(t *testing.T) {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	if err := Run(out, []string{src}, &Options{AllFuncs: true, Verbosity: Verbose}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	want := "Skipped F: already tested\nSkipped init: unsupported signature\nGenerated TestG\n"
+	if !strings.HasPrefix(out.String(), want) {
+		t.Errorf("Run() =\n%v, want prefix\n%v", out, want)
+	}
+}
+
+func TestRunSummary(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"p.go":      "package p\n\nfunc F() int { return 0 }\n\nfunc G() int { return 1 }\n\nfunc init() {}\n",
+		"p_test.go": "package p\n\nimport \"testing\"\n\nfunc TestF(t *testing.T) {}\n",
+		"q.go":      "package p\n\nfunc H() int { return 2 }\n",
+	}
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	out := &bytes.Buffer{}
+	args := []string{filepath.Join(dir, "p.go"), filepath.Join(dir, "q.go")}
+	got, err := RunSummary(out, args, &Options{AllFuncs: true, ExclFuncs: "^G$", WriteOutput: true, PrintSummary: true})
+	if err != nil {
+		t.Fatalf("RunSummary() error = %v", err)
+	}
+	want := Summary{Paths: 2, Tests: 1, Tested: 1, FilteredOut: 1, Unsupported: 1, Written: 1}
+	if got != want {
+		t.Errorf("RunSummary() = %+v, want %+v", got, want)
+	}
+	if line := want.String() + "\n"; !strings.HasSuffix(out.String(), line) {
+		t.Errorf("RunSummary() =\n%v, want suffix\n%v", out, line)
+	}
+}
+
+func TestRunLogger(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "p.go")
+	if err := ioutil.WriteFile(src, []byte("package p\n\nfunc F() int { return 0 }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out, log := &bytes.Buffer{}, &bytes.Buffer{}
+	if err := Run(out, []string{src}, &Options{AllFuncs: true, PrintSummary: true, Logger: log}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "// Code generated by gotests") || strings.Contains(out.String(), "Generated TestF") {
+		t.Errorf("Run() output =\n%v, want only the generated code", out)
+	}
+	want := "Generated TestF\n" + Summary{Paths: 1, Tests: 1}.String() + "\n"
+	if log.String() != want {
+		t.Errorf("Run() logs =\n%v, want\n%v", log, want)
+	}
+}
+
+func TestRunAllowError(t *testing.T) {
+	var args []string
+	for i, src := range []string{"package p\n\nfunc F( {\n", "package p\n\nfunc F() int { return 0 }\n", "package p\n\nfunc G(\n"} {
+		path := filepath.Join(t.TempDir(), fmt.Sprintf("p%v.go", i))
+		if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+		args = append(args, path)
+	}
+	out := &bytes.Buffer{}
+	err := Run(out, args, &Options{AllFuncs: true, AllowError: true})
+	errs, ok := err.(Errors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Run() error = %v, want the Errors of 2 paths", err)
+	}
+	for i, want := range []string{args[0], args[2]} {
+		if pe, ok := errs[i].(*PathError); !ok || pe.Path != want {
+			t.Errorf("Run() error %v = %v, want a PathError of %v", i, errs[i], want)
+		}
+	}
+	if !strings.HasPrefix(err.Error(), "Failed to generate tests for 2 paths:\n\t"+args[0]+": ") {
+		t.Errorf("Run() error = %v, want the count and list of the failures", err)
+	}
+	if !strings.Contains(out.String(), "func TestF(t *testing.T)") {
+		t.Errorf("Run() output =\n%v, want the test of the path that succeeded", out)
+	}
+}
+
+func TestRunGenerated(t *testing.T) {
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"gen.go": "// Code generated by stringer. DO NOT EDIT.\n\npackage p\n\nfunc G() int { return 0 }\n",
+		"p.go":   "package p\n\nfunc F() int { return 0 }\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	out, log := &bytes.Buffer{}, &bytes.Buffer{}
+	if err := Run(out, []string{dir}, &Options{AllFuncs: true, Logger: log}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "func TestF(") || strings.Contains(out.String(), "func TestG(") {
+		t.Errorf("Run() output =\n%v, want only the test of the handwritten file", out)
+	}
+	if want := "Skipped generated file " + filepath.Join(dir, "gen.go") + "\n"; !strings.HasPrefix(log.String(), want) {
+		t.Errorf("Run() logs =\n%v, want prefix %q", log, want)
+	}
+	out.Reset()
+	if err := Run(out, []string{dir}, &Options{AllFuncs: true, IncludeGenerated: true, Logger: log}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "func TestG(") {
+		t.Errorf("Run() output =\n%v, want the test of the generated file with IncludeGenerated", out)
+	}
+}
+
+func TestRunSkipUnexposable(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "p.go")
+	if err := ioutil.WriteFile(src, []byte("package p\n\ntype opts struct{}\n\nfunc F(o opts) int { return 0 }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	sum, err := RunSummary(out, []string{src}, &Options{AllFuncs: true, External: true, SkipUnexposable: true})
+	if err != nil {
+		t.Fatalf("RunSummary() error = %v", err)
+	}
+	if sum.Unsupported != 1 {
+		t.Errorf("RunSummary() = %+v, want 1 unsupported function", sum)
+	}
+	if want := "Warning: skipped F: unexported types in its signature\n"; !strings.Contains(out.String(), want) {
+		t.Errorf("RunSummary() =\n%v, want to contain\n%v", out, want)
+	}
+}
+
+func TestRunUnchanged(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "p.go")
+	if err := ioutil.WriteFile(src, []byte("package p\n\nfunc F() int { return 0 }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// The tests are written apart from the source, so that they're generated
+	// again.
+	opts := &Options{AllFuncs: true, WriteOutput: true, OutputPath: "{{.Dir}}/tests/{{.Name}}_test.go"}
+	testPath := filepath.Join(dir, "tests", "p_test.go")
+	out := &bytes.Buffer{}
+	if sum, err := RunSummary(out, []string{src}, opts); err != nil || sum.Written != 1 {
+		t.Fatalf("RunSummary() = %+v, %v, want 1 file written", sum, err)
+	}
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(testPath, old, old); err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	sum, err := RunSummary(out, []string{src}, opts)
+	if err != nil {
+		t.Fatalf("RunSummary() error = %v", err)
+	}
+	if sum.Written != 0 || sum.Unchanged != 1 {
+		t.Errorf("RunSummary() = %+v, want 1 file unchanged", sum)
+	}
+	if out.Len() != 0 {
+		t.Errorf("RunSummary() =\n%v, want no output", out)
+	}
+	if fi, err := os.Stat(testPath); err != nil || !fi.ModTime().Equal(old) {
+		t.Errorf("RunSummary() rewrote %v", testPath)
+	}
+}
+
+func TestRunCache(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "p.go")
+	if err := ioutil.WriteFile(src, []byte("package p\n\nfunc F() int { return 0 }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tmplDir := filepath.Join(dir, "templates")
+	tmpl := filepath.Join(tmplDir, "extra.tmpl")
+	if err := os.Mkdir(tmplDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(tmpl, []byte(`{{define "extra"}}1{{end}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// The tests are written apart from the source, so that they're generated
+	// again unless they're cached.
+	opts := &Options{AllFuncs: true, WriteOutput: true, OutputPath: "{{.Dir}}/tests/{{.Name}}_test.go", CacheDir: filepath.Join(dir, "cache"), TemplateDir: tmplDir, Verbosity: Verbose}
+	testPath := filepath.Join(dir, "tests", "p_test.go")
+	tests := []struct {
+		name       string
+		change     func() error
+		parallel   bool
+		wantCached bool
+	}{
+		{
+			name: "Miss",
+		}, {
+			name:       "Hit",
+			wantCached: true,
+		}, {
+			name: "Source changed",
+			change: func() error {
+				return ioutil.WriteFile(src, []byte("package p\n\nfunc F() int { return 1 }\n"), 0644)
+			},
+		}, {
+			name:       "Hit after the source changed",
+			wantCached: true,
+		}, {
+			name: "Template changed",
+			change: func() error {
+				return ioutil.WriteFile(tmpl, []byte(`{{define "extra"}}2{{end}}`), 0644)
+			},
+		}, {
+			name: "Package changed",
+			change: func() error {
+				return ioutil.WriteFile(filepath.Join(dir, "q.go"), []byte("package p\n\ntype T int\n"), 0644)
+			},
+		}, {
+			name:       "Hit after the package changed",
+			wantCached: true,
+		}, {
+			name:     "Options changed",
+			parallel: true,
+		}, {
+			name:     "Test file changed",
+			parallel: true,
+			change: func() error {
+				return ioutil.WriteFile(testPath, []byte("package p\n"), 0644)
+			},
+		},
+	}
+	for _, tt := range tests {
+		if tt.change != nil {
+			if err := tt.change(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		o := *opts
+		o.Parallel = tt.parallel
+		out := &bytes.Buffer{}
+		sum, err := RunSummary(out, []string{src}, &o)
+		if err != nil {
+			t.Fatalf("%q. RunSummary() error = %v", tt.name, err)
+		}
+		if cached := strings.Contains(out.String(), "Cached "+src+"\n"); cached != tt.wantCached {
+			t.Errorf("%q. RunSummary() =\n%v, cached = %v, want %v", tt.name, out, cached, tt.wantCached)
+		}
+		if tt.wantCached && (sum.Tests != 0 || sum.Written != 0 || sum.Unchanged != 1) {
+			t.Errorf("%q. RunSummary() = %+v, want 1 file unchanged", tt.name, sum)
+		}
+		if !tt.wantCached && sum.Tests != 1 {
+			t.Errorf("%q. RunSummary() = %+v, want 1 test generated", tt.name, sum)
+		}
+	}
+}
+
+func TestRunRelativePaths(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "p.go")
+	if err := ioutil.WriteFile(src, []byte("package p\n\nfunc F() int { return 0 }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// The directory of the tests is taken by a file, so they fail to be
+	// written.
+	if err := ioutil.WriteFile(filepath.Join(dir, "tests"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	opts := &Options{AllFuncs: true, WriteOutput: true, OutputPath: "{{.Dir}}/tests/{{.Name}}_test.go", RelativePaths: true, PathBase: dir}
+	err := Run(&bytes.Buffer{}, []string{src}, opts)
+	var pe *os.PathError
+	if !errors.As(err, &pe) || pe.Path != "tests" {
+		t.Errorf("Run() error = %v, want the relative path tests", err)
+	}
+	opts = &Options{OnlyFuncs: "G", RelativePaths: true, PathBase: dir}
+	out := &bytes.Buffer{}
+	if err := Run(out, []string{src}, opts); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if want := "No tests generated for p.go\n"; out.String() != want {
+		t.Errorf("Run() =\n%v, want\n%v", out, want)
+	}
+}
+
+func TestRunTestingPkg(t *testing.T) {
+	stderr := &bytes.Buffer{}
+	opts := &Options{OnlyFuncs: "FooBar", TestingPkg: "testing/iotest", NoConfig: true, Stderr: stderr}
+	if err := Run(&bytes.Buffer{}, []string{"testdata/foobar.go"}, opts); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if want := "Warning: testing/iotest has no T type"; !strings.HasPrefix(stderr.String(), want) {
+		t.Errorf("Run() warned %q, want %q", stderr, want)
+	}
+	stderr.Reset()
+	opts.TestingPkg = "testing"
+	if err := Run(&bytes.Buffer{}, []string{"testdata/foobar.go"}, opts); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if stderr.Len() != 0 {
+		t.Errorf("Run() warned %q, want no warning", stderr)
+	}
+}
+
+func TestRunProgress(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.go", "b.go", "c.go"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte("package p\n\nfunc F() int { return 0 }\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var got []string
+	progress := func(path string, index, total int) {
+		got = append(got, fmt.Sprintf("%v %v/%v", filepath.Base(path), index, total))
+	}
+	opts := &Options{AllFuncs: true, Recursive: true, Parallelism: 2, Progress: progress}
+	if err := Run(&bytes.Buffer{}, []string{dir}, opts); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	sort.Strings(got)
+	want := []string{"a.go 0/3", "b.go 1/3", "c.go 2/3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Run() reported progress %q, want %q", got, want)
+	}
+}
+
+func TestRunProgressCanceled(t *testing.T) {
+	// The sources are in packages of their own, so that only the first fails.
+	root := t.TempDir()
+	var paths []string
+	for i, name := range []string{"a", "b", "c", "d", "e"} {
+		src := "package p\n\nfunc F() int { return 0 }\n"
+		if i == 0 {
+			src = "package p\n\nfunc F( {\n"
+		}
+		dir := filepath.Join(root, name)
+		if err := os.Mkdir(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, "p.go")
+		if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+	var got []string
+	progress := func(path string, index, total int) {
+		got = append(got, path)
+	}
+	opts := &Options{AllFuncs: true, WriteOutput: true, Parallelism: 1, Progress: progress}
+	if err := Run(&bytes.Buffer{}, paths, opts); err == nil {
+		t.Fatal("Run() error = nil, want the error of the first path")
+	}
+	// The paths processed are the failing one and those whose tests were
+	// written before the run was canceled.
+	want := paths[:1]
+	for _, path := range paths[1:] {
+		if _, err := os.Stat(strings.TrimSuffix(path, ".go") + "_test.go"); err == nil {
+			want = append(want, path)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Run() reported progress %q, want %q", got, want)
+	}
+}
+
+func TestRunPathsFrom(t *testing.T) {
+	dir := t.TempDir()
+	var paths []string
+	for _, name := range []string{"a.go", "b.go"} {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte("package p\n\nfunc "+strings.ToUpper(name[:1])+"() int { return 0 }\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+	missing := filepath.Join(dir, "c.go")
+	list := "# The sources.\n" + paths[0] + "\n\n  " + paths[1] + "\n"
+	manifest := filepath.Join(dir, "paths.txt")
+	if err := ioutil.WriteFile(manifest, []byte(list+missing+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	if err := Run(out, nil, &Options{AllFuncs: true, PathsFrom: manifest}); err == nil || !strings.Contains(err.Error(), "c.go") {
+		t.Errorf("Run() error = %v, want the missing path", err)
+	}
+	out.Reset()
+	if err := Run(out, nil, &Options{AllFuncs: true, AllowError: true, PathsFrom: manifest}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	for _, want := range []string{"func TestA(t *testing.T)", "func TestB(t *testing.T)", "Skipped missing path " + missing + "\n"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Run() =\n%v, want it to contain %q", out, want)
+		}
+	}
+	out.Reset()
+	if err := Run(out, nil, &Options{AllFuncs: true, PathsFrom: "-", Stdin: strings.NewReader(list)}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "func TestB(t *testing.T)") {
+		t.Errorf("Run() =\n%v, want the tests of the paths read from stdin", out)
+	}
+	want := "Cannot read both the source and the -paths-from list from stdin"
+	if err := Run(out, []string{"-"}, &Options{AllFuncs: true, PathsFrom: "-"}); err == nil || err.Error() != want {
+		t.Errorf("Run() error = %v, want %v", err, want)
+	}
+}
+
+func TestRunOverwrite(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"p.go":          "package p\n\nfunc F() int { return 0 }\n\nfunc G() int { return 0 }\n",
+		"p_test.go":     "package p\n\nimport \"testing\"\n\nfunc TestF(t *testing.T) { t.Skip(\"old\") }\n",
+		"other_test.go": "package p\n\nimport \"testing\"\n\nfunc TestG(t *testing.T) {}\n",
+	}
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	out := &bytes.Buffer{}
+	if err := Run(out, []string{filepath.Join(dir, "p.go")}, &Options{AllFuncs: true, Overwrite: true, WriteOutput: true}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "p_test.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(b)
+	if strings.Contains(got, `t.Skip("old")`) || !strings.Contains(got, "got := F()") {
+		t.Errorf("Run() p_test.go =\n%v, want a regenerated TestF", got)
+	}
+	if strings.Contains(got, "TestG") {
+		t.Errorf("Run() p_test.go =\n%v, want no TestG, tested in other_test.go", got)
+	}
+}
+
+// A syncBuffer is a bytes.Buffer safe for concurrent use.
+type syncBuffer struct {
+	mu sync.Mutex
+	b  bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.String()
+}
+
+func TestRunWatch(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "p.go")
+	if err := ioutil.WriteFile(src, []byte("package p\n\nfunc F() int { return 0 }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stop := make(chan struct{})
+	log := &syncBuffer{}
+	done := make(chan error)
+	go func() {
+		done <- Run(ioutil.Discard, []string{dir}, &Options{AllFuncs: true, WriteOutput: true, AllowError: true, Watch: true, StopWatch: stop, Logger: log})
+	}()
+	// waitFor waits for the logs to contain s.
+	waitFor := func(s string) {
+		t.Helper()
+		for deadline := time.Now().Add(5 * time.Second); !strings.Contains(log.String(), s); time.Sleep(10 * time.Millisecond) {
+			if time.Now().After(deadline) {
+				close(stop)
+				t.Fatalf("Run() logs =\n%v, want to contain %q", log, s)
+			}
+		}
+	}
+	waitFor("Generated TestF\n")
+	// The parse error of a file being edited doesn't stop the watch.
+	if err := ioutil.WriteFile(src, []byte("package p\n\nfunc F() int { return 0 }\n\nfunc G(\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(src + ": ")
+	if err := ioutil.WriteFile(src, []byte("package p\n\nfunc F() int { return 0 }\n\nfunc G() int { return 0 }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitFor("Generated TestG\n")
+	close(stop)
+	if err := <-done; err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "p_test.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(b); !strings.Contains(got, "func TestF(") || !strings.Contains(got, "func TestG(") {
+		t.Errorf("Run() p_test.go =\n%v, want TestF and TestG", got)
+	}
+}
+
+func TestRunHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *Options
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Default",
+			opts: &Options{},
+			want: "// Code generated by gotests. DO NOT EDIT.\n\npackage foobar\n",
+		}, {
+			name: "License",
+			opts: &Options{HeaderComment: "/*\nLicensed under the Apache License.\n*/"},
+			want: "/*\nLicensed under the Apache License.\n*/\n\npackage foobar\n",
+		}, {
+			name: "None",
+			opts: &Options{HeaderComment: "none"},
+			want: "package foobar\n",
+		}, {
+			name:    "Not a comment",
+			opts:    &Options{HeaderComment: "Copyright 2024"},
+			wantErr: true,
+		}, {
+			name:    "Nonexistent file",
+			opts:    &Options{HeaderFile: "testdata/nonexistent"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		out := &bytes.Buffer{}
+		tt.opts.AllFuncs = true
+		tt.opts.JSONOutput = true
+		err := Run(out, []string{"testdata/foobar.go"}, tt.opts)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q. Run() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		var got []struct {
+			Source string `json:"source"`
+		}
+		if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+			t.Fatalf("%q. json.Unmarshal(%q) error = %v", tt.name, out, err)
+		}
+		if len(got) != 1 || !strings.HasPrefix(got[0].Source, tt.want) {
+			t.Errorf("%q. Run() = %+v, want a source starting with %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRunHeaderExisting(t *testing.T) {
+	for _, merge := range []bool{false, true} {
+		dir := t.TempDir()
+		files := map[string]string{
+			"p.go":      "package p\n\nfunc F() int { return 0 }\n\nfunc G() int { return 0 }\n",
+			"p_test.go": "package p\n\nimport \"testing\"\n\nfunc TestF(t *testing.T) {}\n",
+		}
+		for name, src := range files {
+			if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		out := &bytes.Buffer{}
+		if err := Run(out, []string{filepath.Join(dir, "p.go")}, &Options{AllFuncs: true, WriteOutput: true, Merge: merge}); err != nil {
+			t.Fatalf("Run(Merge: %v) error = %v", merge, err)
+		}
+		b, err := ioutil.ReadFile(filepath.Join(dir, "p_test.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(b), "func TestG(") || !strings.HasPrefix(string(b), "package p\n") {
+			t.Errorf("Run(Merge: %v) wrote\n%s\nwant TestG added without a header comment", merge, b)
+		}
+	}
+}
+
+func TestRunNameLists(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *Options
+		want []string
+	}{
+		{
+			name: "OnlyList",
+			opts: &Options{OnlyList: "Bar.bar"},
+			want: []string{"TestBar_bar"},
+		}, {
+			name: "OnlyList with OnlyFuncs",
+			opts: &Options{OnlyFuncs: "^Foo$", OnlyList: " Bar.bar "},
+			want: []string{"TestFoo_Foo", "TestBar_bar"},
+		}, {
+			name: "ExclList",
+			opts: &Options{AllFuncs: true, ExclList: "Foo.Foo"},
+			want: []string{"TestBar_bar"},
+		}, {
+			name: "ExclList with ExclFuncs",
+			opts: &Options{AllFuncs: true, ExclFuncs: "bar", ExclList: "Foo.Foo"},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		out := &bytes.Buffer{}
+		tt.opts.JSONOutput = true
+		if err := Run(out, []string{"testdata/foobar.go"}, tt.opts); err != nil {
+			t.Fatalf("%q. Run() error = %v", tt.name, err)
+		}
+		var got []struct {
+			Functions []string `json:"functions"`
+		}
+		if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+			t.Fatalf("%q. json.Unmarshal(%q) error = %v", tt.name, out, err)
+		}
+		var fs []string
+		for _, g := range got {
+			fs = append(fs, g.Functions...)
+		}
+		if !reflect.DeepEqual(fs, tt.want) {
+			t.Errorf("%q. Run() functions = %v, want %v", tt.name, fs, tt.want)
+		}
+	}
+}
+
+func TestRunFilters(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *Options
+		want []string
+	}{
+		{
+			name: "AllFuncs",
+			opts: &Options{AllFuncs: true},
+			want: []string{"TestParse", "Test_parseInt", "Test_parseFloat", "TestFormat", "Test_format"},
+		}, {
+			name: "ExportedFuncs",
+			opts: &Options{ExportedFuncs: true},
+			want: []string{"TestParse", "TestFormat"},
+		}, {
+			name: "OnlyFuncs",
+			opts: &Options{OnlyFuncs: "^parse"},
+			want: []string{"Test_parseInt", "Test_parseFloat"},
+		}, {
+			name: "ExclFuncs",
+			opts: &Options{ExclFuncs: "^parse"},
+			want: []string{"TestParse", "TestFormat", "Test_format"},
+		}, {
+			name: "AllFuncs with ExportedFuncs",
+			opts: &Options{AllFuncs: true, ExportedFuncs: true},
+			want: []string{"TestParse", "TestFormat"},
+		}, {
+			name: "AllFuncs with OnlyFuncs",
+			opts: &Options{AllFuncs: true, OnlyFuncs: "^parse"},
+			want: []string{"Test_parseInt", "Test_parseFloat"},
+		}, {
+			name: "AllFuncs with ExclFuncs",
+			opts: &Options{AllFuncs: true, ExclFuncs: "(?i)^parse"},
+			want: []string{"TestFormat", "Test_format"},
+		}, {
+			name: "AllFuncs with OnlyFuncs and ExclFuncs",
+			opts: &Options{AllFuncs: true, OnlyFuncs: "^parse", ExclFuncs: "Float$"},
+			want: []string{"Test_parseInt"},
+		}, {
+			name: "ExportedFuncs with OnlyFuncs",
+			opts: &Options{ExportedFuncs: true, OnlyFuncs: "(?i)^parse"},
+			want: []string{"TestParse"},
+		}, {
+			name: "ExportedFuncs with ExclFuncs",
+			opts: &Options{ExportedFuncs: true, ExclFuncs: "^Parse$"},
+			want: []string{"TestFormat"},
+		}, {
+			name: "OnlyFuncs with ExclFuncs",
+			opts: &Options{OnlyFuncs: "(?i)^parse", ExclFuncs: "Int$"},
+			want: []string{"TestParse", "Test_parseFloat"},
+		}, {
+			name: "ExportedFuncs with unexported OnlyFuncs",
+			opts: &Options{ExportedFuncs: true, OnlyFuncs: "^parse"},
+			want: nil,
+		}, {
+			name: "All filters",
+			opts: &Options{AllFuncs: true, ExportedFuncs: true, OnlyFuncs: "(?i)^(parse|format)", ExclFuncs: "^Format$"},
+			want: []string{"TestParse"},
+		},
+	}
+	for _, tt := range tests {
+		out := &bytes.Buffer{}
+		tt.opts.JSONOutput = true
+		if err := Run(out, []string{"testdata/parse.go"}, tt.opts); err != nil {
+			t.Fatalf("%q. Run() error = %v", tt.name, err)
+		}
+		var got []struct {
+			Functions []string `json:"functions"`
+		}
+		if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+			t.Fatalf("%q. json.Unmarshal(%q) error = %v", tt.name, out, err)
+		}
+		var fs []string
+		for _, g := range got {
+			fs = append(fs, g.Functions...)
+		}
+		if !reflect.DeepEqual(fs, tt.want) {
+			t.Errorf("%q. Run() functions = %v, want %v", tt.name, fs, tt.want)
+		}
+	}
+}
+
+func TestRunOutputPath(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.go", "b.go"} {
+		src := "package p\n\nfunc " + strings.ToUpper(name[:1]) + "() int { return 0 }\n"
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	out := &bytes.Buffer{}
+	opts := &Options{AllFuncs: true, WriteOutput: true, OutputPath: "{{.Dir}}/tests/{{.Name}}_test.go"}
+	if err := Run(out, []string{filepath.Join(dir, "a.go")}, opts); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "tests", "a_test.go")); err != nil {
+		t.Errorf("Run() didn't write tests/a_test.go: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "a_test.go")); !os.IsNotExist(err) {
+		t.Errorf("Run() wrote a_test.go next to the source, want only tests/a_test.go")
+	}
+
+	opts.OutputPath = "{{.Dir}}/all_test.go"
+	err := Run(out, []string{filepath.Join(dir, "a.go"), filepath.Join(dir, "b.go")}, opts)
+	if want := "would both be written to " + filepath.Join(dir, "all_test.go"); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Run() error = %v, want it to contain %v", err, want)
+	}
+}
+
+func TestRunConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		file       string
+		config     string
+		opts       *Options
+		want       []string
+		wantErr    bool
+		wantStderr string
+	}{
+		{
+			name:   "YAML",
+			file:   ".gotests.yml",
+			config: "only: ^Foo$\n",
+			opts:   &Options{},
+			want:   []string{"TestFoo_Foo"},
+		}, {
+			name:   "JSON",
+			file:   ".gotests.json",
+			config: `{"only-names": "Bar.bar"}`,
+			opts:   &Options{},
+			want:   []string{"TestBar_bar"},
+		}, {
+			name:   "Options take precedence without Flags",
+			file:   ".gotests.yml",
+			config: "only: ^Foo$\n",
+			opts:   &Options{OnlyFuncs: "bar"},
+			want:   []string{"TestBar_bar"},
+		}, {
+			name:   "Flags take precedence",
+			file:   ".gotests.yml",
+			config: "excl: Foo\n",
+			opts:   &Options{AllFuncs: true, Flags: map[string]bool{"excl": true}},
+			want:   []string{"TestFoo_Foo", "TestBar_bar"},
+		}, {
+			name:    "NoConfig",
+			file:    ".gotests.yml",
+			config:  "all: true\n",
+			opts:    &Options{NoConfig: true},
+			wantErr: true,
+		}, {
+			name:       "Invalid config",
+			file:       ".gotests.yml",
+			config:     "only: [\n",
+			opts:       &Options{},
+			wantErr:    true,
+			wantStderr: "Warning: ignoring ",
+		}, {
+			name:       "Unknown and mistyped keys",
+			file:       ".gotests.yml",
+			config:     "all: true\nunknown: 1\nexcl: 2\n",
+			opts:       &Options{},
+			want:       []string{"TestFoo_Foo", "TestBar_bar"},
+			wantStderr: `Warning: ignoring option "excl"`,
+		}, {
+			name:    "Durations",
+			file:    ".gotests.yml",
+			config:  "all: true\ncase-timeout: 2s\n",
+			opts:    &Options{},
+			wantErr: true, // -case-timeout requires subtests.
+		}, {
+			name:       "Mistyped durations",
+			file:       ".gotests.yml",
+			config:     "all: true\ncase-timeout: 2\n",
+			opts:       &Options{},
+			want:       []string{"TestFoo_Foo", "TestBar_bar"},
+			wantStderr: `Warning: ignoring option "case-timeout" in`,
+		},
+	}
+	for _, tt := range tests {
+		dir := t.TempDir()
+		if err := ioutil.WriteFile(filepath.Join(dir, tt.file), []byte(tt.config), 0644); err != nil {
+			t.Fatal(err)
+		}
+		// The config file is found in the parents of ConfigDir.
+		sub := filepath.Join(dir, "sub")
+		if err := os.Mkdir(sub, 0755); err != nil {
+			t.Fatal(err)
+		}
+		out, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		tt.opts.JSONOutput = true
+		tt.opts.ConfigDir = sub
+		tt.opts.Stderr = stderr
+		err := Run(out, []string{"testdata/foobar.go"}, tt.opts)
+		if !strings.Contains(stderr.String(), tt.wantStderr) {
+			t.Errorf("%q. Run() stderr = %q, want it to contain %q", tt.name, stderr, tt.wantStderr)
+		}
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q. Run() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		var got []struct {
+			Functions []string `json:"functions"`
+		}
+		if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+			t.Fatalf("%q. json.Unmarshal(%q) error = %v", tt.name, out, err)
+		}
+		var fs []string
+		for _, g := range got {
+			fs = append(fs, g.Functions...)
+		}
+		if !reflect.DeepEqual(fs, tt.want) {
+			t.Errorf("%q. Run() functions = %v, want %v", tt.name, fs, tt.want)
+		}
+	}
+}
+
+func TestApplyConfigInverse(t *testing.T) {
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, ".gotests.json"), []byte(`{"fiximports": false, "fillcontext": false}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if o := applyConfig(&Options{ConfigDir: dir}); !o.NoFixImports || !o.NoFillContext {
+		t.Errorf("applyConfig() NoFixImports, NoFillContext = %v, %v, want the inverses of fiximports and fillcontext", o.NoFixImports, o.NoFillContext)
+	}
+	if o := applyConfig(&Options{ConfigDir: dir, Flags: map[string]bool{"fiximports": true}}); o.NoFixImports {
+		t.Errorf("applyConfig() NoFixImports = true, want the -fiximports flag to take precedence")
+	}
+}
+
+func TestParseOptionsFields(t *testing.T) {
+	// Those are set by parseOptions from the options of other names or
+	// types, or only by the callers of gotests.
+	set := map[string]bool{
+		"Only": true, "Exclude": true, "OnlyReceiver": true, "ExclReceiver": true, "Exported": true,
+		"BenchSizes": true, "TestNameTemplate": true, "TemplateParams": true, "BuildTags": true,
+		"Importer": true, "TemplateFuncs": true, "Skipped": true, "SkippedGenerated": true, "Transform": true,
+	}
+	gt := reflect.TypeOf(gotests.Options{})
+	pt := reflect.TypeOf(Options{})
+	for i := 0; i < gt.NumField(); i++ {
+		f := gt.Field(i)
+		pf, ok := pt.FieldByName(f.Name)
+		if copied := ok && pf.Type.AssignableTo(f.Type); copied == set[f.Name] {
+			t.Errorf("gotests.Options.%v copied = %v, want %v", f.Name, copied, !set[f.Name])
+		}
+	}
+}
+
+func TestRunIgnore(t *testing.T) {
+	tests := []struct {
+		name    string
+		ignore  string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "No patterns",
+			want: []string{"TestA", "TestP", "TestK", "TestG", "TestM", "TestN"},
+		}, {
+			name:   "Directory",
+			ignore: "# Generated code.\ngen/\n",
+			want:   []string{"TestA", "TestP", "TestK", "TestM", "TestN"},
+		}, {
+			name:   "Extension",
+			ignore: "*.pb.go\n",
+			want:   []string{"TestA", "TestG", "TestM", "TestN"},
+		}, {
+			name:   "Negated",
+			ignore: "*.pb.go\n!keep.pb.go\n",
+			want:   []string{"TestA", "TestK", "TestG", "TestM", "TestN"},
+		}, {
+			name:   "Anchored",
+			ignore: "/mocks\n",
+			want:   []string{"TestA", "TestP", "TestK", "TestG", "TestN"},
+		}, {
+			name:   "Any directory",
+			ignore: "**/mocks/*.go\n",
+			want:   []string{"TestA", "TestP", "TestK", "TestG"},
+		}, {
+			name:    "Invalid",
+			ignore:  "[\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		dir := t.TempDir()
+		for name, fn := range map[string]string{
+			"a.go":           "A",
+			"api/api.pb.go":  "P",
+			"api/keep.pb.go": "K",
+			"gen/g.go":       "G",
+			"mocks/m.go":     "M",
+			"sub/mocks/n.go": "N",
+			".gotestsignore": "",
+		} {
+			src := "package p\n\nfunc " + fn + "() int { return 0 }\n"
+			if fn == "" {
+				src = tt.ignore
+			}
+			path := filepath.Join(dir, filepath.FromSlash(name))
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		out := &bytes.Buffer{}
+		err := Run(out, []string{dir}, &Options{AllFuncs: true, Recursive: true, JSONOutput: true, NoConfig: true, ConfigDir: dir})
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q. Run() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		var got []struct {
+			Functions []string `json:"functions"`
+		}
+		if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+			t.Fatalf("%q. json.Unmarshal(%q) error = %v", tt.name, out, err)
+		}
+		var fs []string
+		for _, g := range got {
+			fs = append(fs, g.Functions...)
+		}
+		if !reflect.DeepEqual(fs, tt.want) {
+			t.Errorf("%q. Run() functions = %v, want %v", tt.name, fs, tt.want)
+		}
+	}
+}
+
+func TestParseFileMode(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    os.FileMode
+		wantErr bool
+	}{
+		{s: "0664", want: 0664},
+		{s: "600", want: 0600},
+		{s: "0444", wantErr: true},
+		{s: "01777", wantErr: true},
+		{s: "0689", wantErr: true},
+		{s: "rw-r--r--", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseFileMode(tt.s)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseFileMode(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseFileMode(%q) = %#o, want %#o", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestRunFileMode(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "p.go")
+	if err := ioutil.WriteFile(src, []byte("package p\n\nfunc F() int { return 0 }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	if err := Run(out, []string{src}, &Options{AllFuncs: true, WriteOutput: true, FileMode: 0600}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	fi, err := os.Stat(filepath.Join(dir, "p_test.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fi.Mode().Perm(); got != 0600 {
+		t.Errorf("Run() wrote p_test.go with mode %#o, want %#o", got, 0600)
+	}
+	if err := Run(out, []string{src}, &Options{AllFuncs: true, FileMode: 0400}); err == nil {
+		t.Errorf("Run() with FileMode 0400 error = nil, want an error")
+	}
+}
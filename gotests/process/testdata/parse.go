@@ -0,0 +1,11 @@
+package parse
+
+func Parse(s string) int { return len(s) }
+
+func parseInt(s string) int { return len(s) }
+
+func parseFloat(s string) float64 { return float64(len(s)) }
+
+func Format(n int) string { return "" }
+
+func format(n int) string { return "" }
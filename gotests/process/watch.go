@@ -0,0 +1,165 @@
+package process
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cweill/gotests"
+	"github.com/fsnotify/fsnotify"
+)
+
+// How long to wait for the events of a change to settle, such as the
+// successive writes of an editor saving a file, before regenerating its tests.
+var watchDelay = 100 * time.Millisecond
+
+// watch regenerates the tests of the source files under args that are
+// written or created, other than those that ignore ignores, until
+// opts.StopWatch is closed. The errors of the
+// regeneration are logged to log and ignored if opts.AllowError is set, or
+// else returned. It adds what it processes to sum.
+func watch(out, log io.Writer, sum *Summary, args []string, ignore *ignoreList, opts *Options, opt *gotests.Options, ops *outputPaths) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("fsnotify.NewWatcher: %v", err)
+	}
+	defer w.Close()
+	ws, err := newWatchSet(args, opts.Recursive, ignore)
+	if err != nil {
+		return err
+	}
+	for _, path := range ws.paths() {
+		if err := w.Add(path); err != nil {
+			return fmt.Errorf("fsnotify.Watcher.Add: %v", err)
+		}
+	}
+	changed := map[string]bool{}
+	timer := time.NewTimer(watchDelay)
+	timer.Stop()
+	for {
+		select {
+		case <-opts.StopWatch:
+			return nil
+		case ev, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+			if ev.Has(fsnotify.Write|fsnotify.Create) && ws.match(ev.Name) {
+				changed[ev.Name] = true
+				timer.Reset(watchDelay)
+			}
+		case err, ok := <-w.Errors:
+			if !ok {
+				return nil
+			}
+			if !opts.AllowError {
+				return err
+			}
+			fmt.Fprintln(log, "Warning:", err)
+		case <-timer.C:
+			paths := make([]string, 0, len(changed))
+			for path := range changed {
+				paths = append(paths, path)
+			}
+			sort.Strings(paths)
+			changed = map[string]bool{}
+			for _, path := range paths {
+				if fi, err := os.Stat(path); err != nil || !fi.Mode().IsRegular() {
+					// It was removed or renamed since.
+					continue
+				}
+				if opts.Verbosity > Quiet {
+					fmt.Fprintln(log, "Regenerating tests for", opts.logPath(path))
+				}
+				if _, err := generateTests(out, log, sum, path, opts, opt, ops); err != nil {
+					err = &PathError{Path: opts.logPath(path), Err: err}
+					if !opts.AllowError {
+						return err
+					}
+					fmt.Fprintln(log, err)
+				}
+			}
+		}
+	}
+}
+
+// watchSet is the set of source files watched for changes: the files given
+// as arguments, and those in the directories given, or in their trees if they
+// are walked recursively, except for those ignored. The directories created
+// after it's made aren't watched.
+type watchSet struct {
+	files  map[string]bool
+	dirs   map[string]bool
+	trees  []string
+	ignore *ignoreList
+	// The directories of all of them, which fsnotify watches.
+	watched map[string]bool
+}
+
+// newWatchSet returns the watchSet of args, whose directories are walked if
+// recursive is set, skipping the paths that ignore ignores.
+func newWatchSet(args []string, recursive bool, ignore *ignoreList) (*watchSet, error) {
+	ws := &watchSet{files: map[string]bool{}, dirs: map[string]bool{}, ignore: ignore, watched: map[string]bool{}}
+	for _, arg := range args {
+		path, err := filepath.Abs(arg)
+		if err != nil {
+			return nil, err
+		}
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case !fi.IsDir():
+			ws.files[path] = true
+			ws.watched[filepath.Dir(path)] = true
+		case recursive:
+			ws.trees = append(ws.trees, path)
+			ws.watched[path] = true
+			paths, err := walk([]string{path}, ignore)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range paths {
+				ws.watched[filepath.Dir(p)] = true
+			}
+		default:
+			ws.dirs[path] = true
+			ws.watched[path] = true
+		}
+	}
+	return ws, nil
+}
+
+// paths returns the directories for fsnotify to watch, in order.
+func (ws *watchSet) paths() []string {
+	paths := make([]string, 0, len(ws.watched))
+	for path := range ws.watched {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// match reports whether path is a source file of ws, rather than a test file
+// or another file of the directories watched.
+func (ws *watchSet) match(path string) bool {
+	name := filepath.Base(path)
+	if filepath.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") || strings.HasPrefix(name, ".") {
+		return false
+	}
+	dir := filepath.Dir(path)
+	if ws.files[path] || ws.dirs[dir] {
+		return true
+	}
+	for _, tree := range ws.trees {
+		if rel, err := filepath.Rel(tree, dir); err == nil && !strings.HasPrefix(rel, "..") {
+			return ws.watched[dir] && !ws.ignore.ignored(path, false)
+		}
+	}
+	return false
+}
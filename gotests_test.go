@@ -2,23 +2,95 @@ package gotests
 
 import (
 	"errors"
+	"go/ast"
 	"go/types"
 	"io/ioutil"
+	"os"
 	"path"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
+	"text/template"
+	"time"
 	"unicode"
 )
 
 func TestGenerateTests(t *testing.T) {
 	type args struct {
-		srcPath     string
-		only        *regexp.Regexp
-		excl        *regexp.Regexp
-		exported    bool
-		printInputs bool
-		subtests    bool
-		importer    types.Importer
+		srcPath         string
+		only            *regexp.Regexp
+		excl            *regexp.Regexp
+		onlyReceiver    *regexp.Regexp
+		exclReceiver    *regexp.Regexp
+		exported        bool
+		printInputs     bool
+		subtests        bool
+		benchmarks      bool
+		benchSizes      []int
+		skipUnexposable bool
+		envSetup        bool
+		sortSlices      bool
+		testName        *template.Template
+		skipNoResult    bool
+		forceInternal   bool
+		forceExternal   bool
+		includeGen      bool
+		caseTimeout     time.Duration
+		numberCases     bool
+		derefPointers   bool
+		captureStdout   bool
+		cases           int
+		buildTags       []string
+		asyncPattern    bool
+		boundaryCases   bool
+		useConstructors bool
+		boolAsError     bool
+		leakCheck       bool
+		leakCheckMain   bool
+		multilineCases  bool
+		useEqualMethod  bool
+		coverageHints   bool
+		testingPkg      string
+		lintFriendly    bool
+		skipEmpty       bool
+		concurrencyCase bool
+		autoName        bool
+		fieldDiff       bool
+		goVersion       string
+		tempDirForPaths bool
+		groupByReceiver bool
+		seedErrorCase   bool
+		templateFuncs   template.FuncMap
+		fuzz            bool
+		cmpDiff         bool
+		merge           bool
+		external        bool
+		rawImports      bool
+		parallel        bool
+		templateDir     string
+		templateParams  map[string]interface{}
+		noFillContext   bool
+		mockInterfaces  bool
+		cleanup         bool
+		helpers         bool
+		errorComparison string
+		caseVarName     string
+		argsStructName  string
+		examples        bool
+		httpHandlers    bool
+		copyDoc         bool
+		assertion       string
+		allowError      bool
+		headerComment   string
+		variadicCases   bool
+		scaffoldArgs    bool
+		panics          bool
+		tableStyle      string
+		loadPackages    bool
+		golden          bool
+		messageFormat   string
+		importer        types.Importer
 	}
 	tests := []struct {
 		name              string
@@ -223,358 +295,1832 @@ func TestGenerateTests(t *testing.T) {
 			},
 			want: mustReadFile(t, "testdata/goldens/function_with_a_variadic_parameter.go"),
 		}, {
-			name: "Function with interface{} parameter and result",
+			name: "Variadic parameter after a fixed one",
 			args: args{
-				srcPath: `testdata/test021.go`,
+				srcPath:  `testdata/test054.go`,
+				subtests: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/function_with_interface_parameter_and_result.go"),
+			want: mustReadFile(t, "testdata/goldens/variadic_parameter_after_a_fixed_one.go"),
 		}, {
-			name: "Function with named imports",
+			name: "Variadic cases",
 			args: args{
-				srcPath: `testdata/test022.go`,
+				srcPath:       `testdata/test054.go`,
+				subtests:      true,
+				variadicCases: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/function_with_named_imports.go"),
+			want: mustReadFile(t, "testdata/goldens/variadic_cases.go"),
 		}, {
-			name: "Function with channel parameter and result",
+			name: "Scaffolded channel and function arguments",
 			args: args{
-				srcPath: `testdata/test023.go`,
+				srcPath:      `testdata/test055.go`,
+				subtests:     true,
+				scaffoldArgs: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/function_with_channel_parameter_and_result.go"),
+			want: mustReadFile(t, "testdata/goldens/scaffolded_channel_and_function_arguments.go"),
 		}, {
-			name: "File with multiple imports",
+			name: "Named results",
 			args: args{
-				srcPath: `testdata/test024.go`,
+				srcPath:  `testdata/test056.go`,
+				subtests: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/file_with_multiple_imports.go"),
+			want: mustReadFile(t, "testdata/goldens/named_results.go"),
 		}, {
-			name: "Function returning two results and an error",
+			name: "Panics",
 			args: args{
-				srcPath: `testdata/test025.go`,
+				srcPath:  `testdata/test057.go`,
+				subtests: true,
+				panics:   true,
 			},
-			want: mustReadFile(t, "testdata/goldens/function_returning_two_results_and_an_error.go"),
+			want: mustReadFile(t, "testdata/goldens/panics.go"),
 		}, {
-			name: "Multiple named results",
+			name: "Panics without subtests",
 			args: args{
-				srcPath: `testdata/test026.go`,
+				srcPath: `testdata/test057.go`,
+				panics:  true,
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_named_results.go"),
+			want: mustReadFile(t, "testdata/goldens/panics_without_subtests.go"),
 		}, {
-			name: "Two different structs with same method name",
+			name: "Panics with testify assertions",
 			args: args{
-				srcPath: `testdata/test027.go`,
+				srcPath:   `testdata/test057.go`,
+				subtests:  true,
+				assertion: "testify",
+				panics:    true,
 			},
-			want: mustReadFile(t, "testdata/goldens/two_different_structs_with_same_method_name.go"),
+			want: mustReadFile(t, "testdata/goldens/panics_with_testify_assertions.go"),
 		}, {
-			name: "Underlying types",
+			name: "Functions tested in another test file",
 			args: args{
-				srcPath: `testdata/test028.go`,
+				srcPath:    `testdata/sibling/sibling.go`,
+				subtests:   true,
+				benchmarks: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/underlying_types.go"),
+			want: mustReadFile(t, "testdata/goldens/functions_tested_in_another_test_file.go"),
 		}, {
-			name: "Struct receiver with multiple fields",
+			name: "Slice table",
 			args: args{
-				srcPath: `testdata/test029.go`,
+				srcPath:    `testdata/test058.go`,
+				subtests:   true,
+				tableStyle: "slice",
 			},
-			want: mustReadFile(t, "testdata/goldens/struct_receiver_with_multiple_fields.go"),
+			want: mustReadFile(t, "testdata/goldens/slice_table.go"),
 		}, {
-			name: "Struct receiver with anonymous fields",
+			name: "Map table",
 			args: args{
-				srcPath: `testdata/test030.go`,
+				srcPath:    `testdata/test058.go`,
+				subtests:   true,
+				tableStyle: "map",
 			},
-			want: mustReadFile(t, "testdata/goldens/struct_receiver_with_anonymous_fields.go"),
+			want: mustReadFile(t, "testdata/goldens/map_table.go"),
 		}, {
-			name: "io.Writer parameters",
+			name: "Map table without subtests",
 			args: args{
-				srcPath: `testdata/test031.go`,
+				srcPath:    `testdata/test058.go`,
+				tableStyle: "map",
 			},
-			want: mustReadFile(t, "testdata/goldens/io_writer_parameters.go"),
+			want: mustReadFile(t, "testdata/goldens/map_table_without_subtests.go"),
 		}, {
-			name: "Two structs with same method name",
+			name: "Map table with variadic cases",
 			args: args{
-				srcPath: `testdata/test032.go`,
+				srcPath:       `testdata/test054.go`,
+				subtests:      true,
+				variadicCases: true,
+				tableStyle:    "map",
 			},
-			want: mustReadFile(t, "testdata/goldens/two_structs_with_same_method_name.go"),
+			want: mustReadFile(t, "testdata/goldens/map_table_with_variadic_cases.go"),
 		}, {
-			name: "Functions and methods with 'name' receivers, parameters, and results",
+			name: "Imports loaded as packages",
 			args: args{
-				srcPath: `testdata/test033.go`,
+				srcPath:        `testdata/loadpkg/loadpkg.go`,
+				subtests:       true,
+				mockInterfaces: true,
+				loadPackages:   true,
 			},
-			want: mustReadFile(t, "testdata/goldens/functions_and_methods_with_name_receivers_parameters_and_results.go"),
+			want: mustReadFile(t, "testdata/goldens/imports_loaded_as_packages.go"),
 		}, {
-			name: "Receiver struct with reserved field names",
+			name: "Golden files",
 			args: args{
-				srcPath: `testdata/test034.go`,
+				srcPath:  `testdata/test059.go`,
+				subtests: true,
+				golden:   true,
 			},
-			want: mustReadFile(t, "testdata/goldens/receiver_struct_with_reserved_field_names.go"),
+			want: mustReadFile(t, "testdata/goldens/golden_files.go"),
 		}, {
-			name: "Receiver struct with fields with complex package names",
+			name: "Golden files without subtests",
 			args: args{
-				srcPath: `testdata/test035.go`,
+				srcPath: `testdata/test059.go`,
+				golden:  true,
 			},
-			want: mustReadFile(t, "testdata/goldens/receiver_struct_with_fields_with_complex_package_names.go"),
+			want: mustReadFile(t, "testdata/goldens/golden_files_without_subtests.go"),
 		}, {
-			name: "Functions and receivers with same names except exporting",
+			name: "Golden files with testify assertions",
 			args: args{
-				srcPath: `testdata/test036.go`,
+				srcPath:   `testdata/test059.go`,
+				subtests:  true,
+				assertion: "testify",
+				golden:    true,
 			},
-			want: mustReadFile(t, "testdata/goldens/functions_and_receivers_with_same_names_except_exporting.go"),
+			want: mustReadFile(t, "testdata/goldens/golden_files_with_testify_assertions.go"),
 		}, {
-			name: "Receiver is indirect imported struct",
+			name: "Golden files with cmp",
 			args: args{
-				srcPath: `testdata/test037.go`,
+				srcPath:  `testdata/test059.go`,
+				subtests: true,
+				cmpDiff:  true,
+				golden:   true,
 			},
-			want: mustReadFile(t, "testdata/goldens/receiver_is_indirect_imported_struct.go"),
+			want: mustReadFile(t, "testdata/goldens/golden_files_with_cmp.go"),
 		}, {
-			name: "Multiple functions",
+			name: "Message format",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
+				srcPath:       `testdata/test060.go`,
+				subtests:      true,
+				messageFormat: "+v",
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions.go"),
+			want: mustReadFile(t, "testdata/goldens/message_format.go"),
 		}, {
-			name: "Multiple functions with only",
+			name: "Methods on defined types and aliases",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
-				only:    regexp.MustCompile("FooFilter|bazFilter"),
+				srcPath:  `testdata/test061.go`,
+				subtests: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_only.go"),
+			want: mustReadFile(t, "testdata/goldens/methods_on_defined_types_and_aliases.go"),
 		}, {
-			name: "Multiple functions with only regexp without matches",
+			name: "Fuzz targets for methods on defined types",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
-				only:    regexp.MustCompile("asdf"),
+				srcPath:  `testdata/test061.go`,
+				subtests: true,
+				fuzz:     true,
 			},
-			wantNoTests: true,
+			want: mustReadFile(t, "testdata/goldens/fuzz_targets_for_methods_on_defined_types.go"),
 		}, {
-			name: "Multiple functions with case-insensitive only",
+			name: "Sub-benchmarks for sizes",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
-				only:    regexp.MustCompile("(?i)fooFilter|BazFilter"),
+				srcPath:    `testdata/test062.go`,
+				subtests:   true,
+				benchmarks: true,
+				benchSizes: []int{10, 100, 1000},
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_case-insensitive_only.go"),
+			want: mustReadFile(t, "testdata/goldens/sub-benchmarks_for_sizes.go"),
 		}, {
-			name: "Multiple functions with only filtering on receiver",
+			name: "Environment setup",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
-				only:    regexp.MustCompile("^BarBarFilter$"),
+				srcPath:  `testdata/test064.go`,
+				subtests: true,
+				envSetup: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_only_filtering_on_receiver.go"),
+			want: mustReadFile(t, "testdata/goldens/environment_setup.go"),
 		}, {
-			name: "Multiple functions with only filtering on method",
+			name: "Environment setup without subtests",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
-				only:    regexp.MustCompile("^(BarFilter)$"),
+				srcPath:  `testdata/test064.go`,
+				envSetup: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_only_filtering_on_method.go"),
+			want: mustReadFile(t, "testdata/goldens/environment_setup_without_subtests.go"),
 		}, {
-			name: "Multiple functions filtering exported",
+			name: "Sorted slices",
 			args: args{
-				srcPath:  `testdata/test_filter.go`,
-				exported: true,
+				srcPath:    `testdata/test065.go`,
+				subtests:   true,
+				sortSlices: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions_filtering_exported.go"),
+			want: mustReadFile(t, "testdata/goldens/sorted_slices.go"),
 		}, {
-			name: "Multiple functions filtering exported with only",
+			name: "Sorted slices with cmp",
 			args: args{
-				srcPath:  `testdata/test_filter.go`,
-				only:     regexp.MustCompile(`FooFilter`),
-				exported: true,
+				srcPath:    `testdata/test065.go`,
+				subtests:   true,
+				cmpDiff:    true,
+				sortSlices: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions_filtering_exported_with_only.go"),
+			want: mustReadFile(t, "testdata/goldens/sorted_slices_with_cmp.go"),
 		}, {
-			name: "Multiple functions filtering all out",
+			name: "Test name template",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
-				only:    regexp.MustCompile("fooFilter"),
+				srcPath:  `testdata/test061.go`,
+				only:     regexp.MustCompile("Fahrenheit|Max|Rename"),
+				subtests: true,
+				testName: template.Must(template.New("").Parse("Test_{{.Package}}_{{with .Receiver}}{{.}}_{{end}}{{.Name}}")),
 			},
-			wantNoTests: true,
+			want: mustReadFile(t, "testdata/goldens/test_name_template.go"),
 		}, {
-			name: "Multiple functions with excl",
+			name: "Skipping functions without results",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
-				excl:    regexp.MustCompile("FooFilter|bazFilter"),
+				srcPath:      `testdata/test066.go`,
+				subtests:     true,
+				skipNoResult: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_excl.go"),
+			want: mustReadFile(t, "testdata/goldens/skipping_functions_without_results.go"),
 		}, {
-			name: "Multiple functions with case-insensitive excl",
+			name: "Skipping functions without results unless matched",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
-				excl:    regexp.MustCompile("(?i)foOFilter|BaZFilter"),
+				srcPath:      `testdata/test066.go`,
+				only:         regexp.MustCompile("Register66"),
+				subtests:     true,
+				skipNoResult: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_case-insensitive_excl.go"),
+			want: mustReadFile(t, "testdata/goldens/skipping_functions_without_results_unless_matched.go"),
 		}, {
-			name: "Multiple functions filtering exported with excl",
+			name: "Conforming to external tests",
 			args: args{
-				srcPath:  `testdata/test_filter.go`,
-				excl:     regexp.MustCompile(`FooFilter`),
-				exported: true,
+				srcPath: `testdata/externaltests/externaltests.go`,
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions_filtering_exported_with_excl.go"),
+			want: mustReadFile(t, "testdata/goldens/conforming_to_external_tests.go"),
 		}, {
-			name: "Multiple functions excluding all",
+			name: "Forcing internal tests",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
-				excl:    regexp.MustCompile("bazFilter|FooFilter|BarFilter"),
+				srcPath:       `testdata/externaltests/externaltests.go`,
+				forceInternal: true,
 			},
-			wantNoTests: true,
+			want: mustReadFile(t, "testdata/goldens/forcing_internal_tests.go"),
 		}, {
-			name: "Multiple functions excluding on receiver",
+			name: "Conforming to internal tests",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
-				excl:    regexp.MustCompile("^BarBarFilter$"),
+				srcPath:  `testdata/test041.go`,
+				only:     regexp.MustCompile("Mix41"),
+				external: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions_excluding_on_receiver.go"),
+			want: mustReadFile(t, "testdata/goldens/conforming_to_internal_tests.go"),
 		}, {
-			name: "Multiple functions excluding on method",
+			name: "Skipping generated files",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
-				excl:    regexp.MustCompile("^BarFilter$"),
+				srcPath: `testdata/generated/mock.go`,
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions_excluding_on_method.go"),
+			wantNoTests: true,
 		}, {
-			name: "Multiple functions with both only and excl",
+			name: "Skipping generated files of a directory",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
-				only:    regexp.MustCompile("BarFilter"),
-				excl:    regexp.MustCompile("FooFilter"),
+				srcPath: `testdata/generated`,
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_both_only_and_excl.go"),
+			want: mustReadFile(t, "testdata/goldens/skipping_generated_files_of_a_directory.go"),
 		}, {
-			name: "Multiple functions with only and excl competing",
+			name: "Including generated files",
 			args: args{
-				srcPath: `testdata/test_filter.go`,
-				only:    regexp.MustCompile("FooFilter|BarFilter"),
-				excl:    regexp.MustCompile("FooFilter|bazFilter"),
+				srcPath:    `testdata/generated/mock.go`,
+				includeGen: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_only_and_excl_competing.go"),
+			want: mustReadFile(t, "testdata/goldens/including_generated_files.go"),
 		}, {
-			name: "Custom importer fails",
+			name: "Subtests with a case timeout",
 			args: args{
-				srcPath:  `testdata/test_filter.go`,
-				importer: &fakeImporter{err: errors.New("error")},
+				srcPath:       `testdata/test067.go`,
+				printInputs:   true,
+				subtests:      true,
+				caseTimeout:   1500 * time.Millisecond,
+				noFillContext: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/custom_importer_fails.go"),
+			want: mustReadFile(t, "testdata/goldens/subtests_with_a_case_timeout.go"),
 		}, {
-			name: "Existing test file",
+			name: "Numbered test cases",
 			args: args{
-				srcPath: `testdata/test100.go`,
+				srcPath:       `testdata/test068.go`,
+				subtests:      true,
+				variadicCases: true,
+				numberCases:   true,
 			},
-			want: mustReadFile(t, "testdata/goldens/existing_test_file.go"),
+			want: mustReadFile(t, "testdata/goldens/numbered_test_cases.go"),
 		}, {
-			name: "Existing test file with just package declaration",
+			name: "Numbered parallel test cases",
 			args: args{
-				srcPath: `testdata/test101.go`,
+				srcPath:     `testdata/test068.go`,
+				subtests:    true,
+				parallel:    true,
+				numberCases: true,
+				goVersion:   "1.21",
 			},
-			want: mustReadFile(t, "testdata/goldens/existing_test_file_with_just_package_declaration.go"),
+			want: mustReadFile(t, "testdata/goldens/numbered_parallel_test_cases.go"),
 		}, {
-			name: "Existing test file with no functions",
+			name: "Dereferenced pointer results",
 			args: args{
-				srcPath: `testdata/test102.go`,
+				srcPath:       `testdata/test069.go`,
+				subtests:      true,
+				derefPointers: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/existing_test_file_with_no_functions.go"),
+			want: mustReadFile(t, "testdata/goldens/dereferenced_pointer_results.go"),
 		}, {
-			name: "Existing test file with multiple imports",
+			name: "Dereferenced pointer results with cmp",
 			args: args{
-				srcPath: `testdata/test200.go`,
+				srcPath:       `testdata/test069.go`,
+				only:          regexp.MustCompile("Cut69"),
+				subtests:      true,
+				cmpDiff:       true,
+				derefPointers: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/existing_test_file_with_multiple_imports.go"),
+			want: mustReadFile(t, "testdata/goldens/dereferenced_pointer_results_with_cmp.go"),
 		}, {
-			name: "Entire testdata directory",
+			name: "Capturing stdout",
 			args: args{
-				srcPath: `testdata/`,
+				srcPath:       `testdata/test070.go`,
+				subtests:      true,
+				captureStdout: true,
 			},
-			wantMultipleTests: true,
+			want: mustReadFile(t, "testdata/goldens/capturing_stdout.go"),
 		}, {
-			name: "Different packages in same directory - part 1",
+			name: "Capturing stdout with cmp",
 			args: args{
-				srcPath: `testdata/mixedpkg/bar.go`,
+				srcPath:       `testdata/test070.go`,
+				only:          regexp.MustCompile("Greet70"),
+				cmpDiff:       true,
+				captureStdout: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/different_packages_in_same_directory_-_part_1.go"),
+			want: mustReadFile(t, "testdata/goldens/capturing_stdout_with_cmp.go"),
 		}, {
-			name: "Different packages in same directory - part 2",
+			name: "Aliasing imports colliding with the package under test",
 			args: args{
-				srcPath: `testdata/mixedpkg/foo.go`,
+				srcPath:       `testdata/aliases/config/config.go`,
+				forceExternal: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/different_packages_in_same_directory_-_part_2.go"),
+			want: mustReadFile(t, "testdata/goldens/aliasing_imports_colliding_with_the_package_under_test.go"),
 		}, {
-			name: "Empty test file",
+			name: "Aliasing imports colliding with the imports of the tests",
 			args: args{
-				srcPath: `testdata/blanktest/blank.go`,
+				srcPath:         `testdata/aliases/config/config.go`,
+				errorComparison: "is",
 			},
-			want: mustReadFile(t, "testdata/goldens/empty_test_file.go"),
+			want: mustReadFile(t, "testdata/goldens/aliasing_imports_colliding_with_the_imports_of_the_tests.go"),
 		}, {
-			name: "Test file with syntax errors",
+			name: "Blank test cases",
 			args: args{
-				srcPath: `testdata/syntaxtest/syntax.go`,
+				srcPath:  `testdata/test071.go`,
+				subtests: true,
+				cases:    3,
 			},
-			want: mustReadFile(t, "testdata/goldens/test_file_with_syntax_errors.go"),
+			want: mustReadFile(t, "testdata/goldens/blank_test_cases.go"),
 		}, {
-			name: "Undefined types",
+			name: "Blank test cases in a map",
 			args: args{
-				srcPath: `testdata/undefinedtypes/undefined.go`,
+				srcPath:    `testdata/test071.go`,
+				subtests:   true,
+				tableStyle: "map",
+				cases:      3,
 			},
-			want: mustReadFile(t, "testdata/goldens/undefined_types.go"),
+			want: mustReadFile(t, "testdata/goldens/blank_test_cases_in_a_map.go"),
 		}, {
-			name: "Subtest Edition - Functions and receivers with same names except exporting",
+			name: "Multiple results and an error",
 			args: args{
-				srcPath:  `testdata/test036.go`,
+				srcPath:  `testdata/test072.go`,
 				subtests: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/subtest_edition_-_functions_and_receivers_with_same_names_except_exporting.go"),
-		},
-		{
-			name: "Init function",
+			want: mustReadFile(t, "testdata/goldens/multiple_results_and_an_error.go"),
+		}, {
+			name: "Multiple results and an error with cmp",
 			args: args{
-				srcPath: `testdata/init_func.go`,
+				srcPath: `testdata/test072.go`,
+				cmpDiff: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/no_init_funcs.go"),
-		},
-		{
-			name: "Existing test file with package level comments",
+			want: mustReadFile(t, "testdata/goldens/multiple_results_and_an_error_with_cmp.go"),
+		}, {
+			name: "Receiving from returned channels",
 			args: args{
-				srcPath: `testdata/test_existing_test_file_with_comments.go`,
+				srcPath:      `testdata/test074.go`,
+				subtests:     true,
+				asyncPattern: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/existing_test_file_with_package_level_comments.go"),
-		},
-		{
-			name: "Existing test file with package level comments with newline",
+			want: mustReadFile(t, "testdata/goldens/receiving_from_returned_channels.go"),
+		}, {
+			name: "Receiving from returned channels with cmp",
 			args: args{
-				srcPath: `testdata/test_existing_test_file_with_comments.go`,
+				srcPath:      `testdata/test074.go`,
+				cmpDiff:      true,
+				asyncPattern: true,
 			},
-			want: mustReadFile(t, "testdata/goldens/existing_test_file_with_package_level_comments.go"),
-		},
-	}
-	tmp, err := ioutil.TempDir("", "gotests_test")
-	if err != nil {
-		t.Fatalf("ioutil.TempDir: %v", err)
-	}
-	for _, tt := range tests {
-		gts, err := GenerateTests(tt.args.srcPath, &Options{
-			Only:        tt.args.only,
-			Exclude:     tt.args.excl,
-			Exported:    tt.args.exported,
-			PrintInputs: tt.args.printInputs,
-			Subtests:    tt.args.subtests,
-			Importer:    func() types.Importer { return tt.args.importer },
-		})
-		if (err != nil) != tt.wantErr {
-			t.Errorf("%q. GenerateTests(%v) error = %v, wantErr %v", tt.name, tt.args.srcPath, err, tt.wantErr)
-			continue
-		}
-		if (len(gts) == 0) != tt.wantNoTests {
-			t.Errorf("%q. GenerateTests(%v) returned no tests", tt.name, tt.args.srcPath)
-			continue
-		}
-		if (len(gts) > 1) != tt.wantMultipleTests {
-			t.Errorf("%q. GenerateTests(%v) returned too many tests", tt.name, tt.args.srcPath)
-			continue
-		}
-		if tt.wantNoTests || tt.wantMultipleTests {
-			continue
-		}
-		if got := string(gts[0].Output); got != tt.want {
-			t.Errorf("%q. GenerateTests(%v) = \n%v, want \n%v", tt.name, tt.args.srcPath, got, tt.want)
-			outputResult(t, tmp, tt.name, gts[0].Output)
-		}
+			want: mustReadFile(t, "testdata/goldens/receiving_from_returned_channels_with_cmp.go"),
+		}, {
+			name: "Receiving from returned channels with testify",
+			args: args{
+				srcPath:      `testdata/test074.go`,
+				subtests:     true,
+				assertion:    "testify",
+				asyncPattern: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/receiving_from_returned_channels_with_testify.go"),
+		}, {
+			name: "Boundary test cases",
+			args: args{
+				srcPath:       `testdata/test075.go`,
+				subtests:      true,
+				boundaryCases: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/boundary_test_cases.go"),
+		}, {
+			name: "Boundary test cases in a map",
+			args: args{
+				srcPath:       `testdata/test075.go`,
+				only:          regexp.MustCompile("Scale75"),
+				subtests:      true,
+				tableStyle:    "map",
+				boundaryCases: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/boundary_test_cases_in_a_map.go"),
+		}, {
+			name: "Receivers built with constructors",
+			args: args{
+				srcPath:         `testdata/test076.go`,
+				subtests:        true,
+				useConstructors: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/receivers_built_with_constructors.go"),
+		}, {
+			name: "Receivers built with constructors in an external package",
+			args: args{
+				srcPath:         `testdata/test076.go`,
+				subtests:        true,
+				forceExternal:   true,
+				useConstructors: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/receivers_built_with_constructors_in_an_external_package.go"),
+		}, {
+			name: "Bool results as errors",
+			args: args{
+				srcPath:     `testdata/test077.go`,
+				subtests:    true,
+				boolAsError: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/bool_results_as_errors.go"),
+		}, {
+			name: "Bool results as errors with cmp",
+			args: args{
+				srcPath:     `testdata/test077.go`,
+				cmpDiff:     true,
+				boolAsError: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/bool_results_as_errors_with_cmp.go"),
+		}, {
+			name: "Goroutine leak checks",
+			args: args{
+				srcPath:   `testdata/test078.go`,
+				subtests:  true,
+				leakCheck: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/goroutine_leak_checks.go"),
+		}, {
+			name: "Goroutine leak checks in TestMain",
+			args: args{
+				srcPath:       `testdata/test078.go`,
+				subtests:      true,
+				leakCheck:     true,
+				leakCheckMain: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/goroutine_leak_checks_in_testmain.go"),
+		}, {
+			name: "Functional options",
+			args: args{
+				srcPath:      `testdata/test079.go`,
+				subtests:     true,
+				scaffoldArgs: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/functional_options.go"),
+		}, {
+			name: "Functional options in an external package",
+			args: args{
+				srcPath:       `testdata/test079.go`,
+				subtests:      true,
+				forceExternal: true,
+				scaffoldArgs:  true,
+			},
+			want: mustReadFile(t, "testdata/goldens/functional_options_in_an_external_package.go"),
+		}, {
+			name: "Multiline test cases",
+			args: args{
+				srcPath:        `testdata/test081.go`,
+				subtests:       true,
+				boundaryCases:  true,
+				multilineCases: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/multiline_test_cases.go"),
+		}, {
+			name: "Results compared with their Equal method",
+			args: args{
+				srcPath:        `testdata/test082.go`,
+				subtests:       true,
+				useEqualMethod: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/results_compared_with_their_equal_method.go"),
+		}, {
+			name: "Results compared with their Equal method with cmp",
+			args: args{
+				srcPath:        `testdata/test082.go`,
+				cmpDiff:        true,
+				useEqualMethod: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/results_compared_with_their_equal_method_with_cmp.go"),
+		}, {
+			name: "Coverage hints",
+			args: args{
+				srcPath:       `testdata/test083.go`,
+				subtests:      true,
+				coverageHints: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/coverage_hints.go"),
+		}, {
+			name: "Custom testing package",
+			args: args{
+				srcPath:    `testdata/test084.go`,
+				subtests:   true,
+				testingPkg: "example.com/xtesting",
+			},
+			want: mustReadFile(t, "testdata/goldens/custom_testing_package.go"),
+		}, {
+			name: "Custom testing package with raw imports",
+			args: args{
+				srcPath:    `testdata/test084.go`,
+				rawImports: true,
+				testingPkg: "example.com/xtesting",
+			},
+			want: mustReadFile(t, "testdata/goldens/custom_testing_package_with_raw_imports.go"),
+		}, {
+			name: "Lint friendly tests",
+			args: args{
+				srcPath:      `testdata/test085.go`,
+				subtests:     true,
+				lintFriendly: true,
+				goVersion:    "1.21",
+			},
+			want: mustReadFile(t, "testdata/goldens/lint_friendly_tests.go"),
+		}, {
+			name: "Lint friendly parallel tests",
+			args: args{
+				srcPath:      `testdata/test085.go`,
+				subtests:     true,
+				parallel:     true,
+				lintFriendly: true,
+				goVersion:    "1.21",
+			},
+			want: mustReadFile(t, "testdata/goldens/lint_friendly_parallel_tests.go"),
+		}, {
+			name: "Functions returning iterators",
+			args: args{
+				srcPath: `testdata/test086.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/functions_returning_iterators.go"),
+		}, {
+			name: "Skipped empty tests",
+			args: args{
+				srcPath:   `testdata/test087.go`,
+				skipEmpty: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/skipped_empty_tests.go"),
+		}, {
+			name: "Skipped empty tests with boundary cases",
+			args: args{
+				srcPath:       `testdata/test087.go`,
+				skipEmpty:     true,
+				boundaryCases: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/skipped_empty_tests_with_boundary_cases.go"),
+		}, {
+			name: "Function with grouped parameters of two types",
+			args: args{
+				srcPath: `testdata/test088.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/function_with_grouped_parameters_of_two_types.go"),
+		}, {
+			name: "Concurrent subtests",
+			args: args{
+				srcPath:         `testdata/test089.go`,
+				subtests:        true,
+				concurrencyCase: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/concurrent_subtests.go"),
+		}, {
+			name: "Methods with only receiver",
+			args: args{
+				srcPath:      `testdata/test090.go`,
+				onlyReceiver: regexp.MustCompile("^Reader90$"),
+			},
+			want: mustReadFile(t, "testdata/goldens/methods_with_only_receiver.go"),
+		}, {
+			name: "Functions and methods with excl receiver",
+			args: args{
+				srcPath:      `testdata/test090.go`,
+				exclReceiver: regexp.MustCompile("^Reader90$"),
+			},
+			want: mustReadFile(t, "testdata/goldens/functions_and_methods_with_excl_receiver.go"),
+		}, {
+			name: "Methods with only and receiver filters",
+			args: args{
+				srcPath:      `testdata/test090.go`,
+				only:         regexp.MustCompile("Len"),
+				onlyReceiver: regexp.MustCompile("90$"),
+				exclReceiver: regexp.MustCompile("^Writer"),
+			},
+			want: mustReadFile(t, "testdata/goldens/methods_with_only_and_receiver_filters.go"),
+		}, {
+			name: "Subtests named after their arguments",
+			args: args{
+				srcPath:  `testdata/test092.go`,
+				subtests: true,
+				autoName: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/subtests_named_after_their_arguments.go"),
+		}, {
+			name: "Struct results compared field by field",
+			args: args{
+				srcPath:   `testdata/test094.go`,
+				fieldDiff: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/struct_results_compared_field_by_field.go"),
+		}, {
+			name: "Struct results compared field by field with cmp",
+			args: args{
+				srcPath:   `testdata/test094.go`,
+				subtests:  true,
+				cmpDiff:   true,
+				fieldDiff: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/struct_results_compared_field_by_field_with_cmp.go"),
+		}, {
+			name: "Path parameters in temporary directories",
+			args: args{
+				srcPath:         `testdata/test096.go`,
+				subtests:        true,
+				tempDirForPaths: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/path_parameters_in_temporary_directories.go"),
+		}, {
+			name: "Tests grouped by receiver type",
+			args: args{
+				srcPath:         `testdata/test098.go`,
+				subtests:        true,
+				groupByReceiver: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/tests_grouped_by_receiver_type.go"),
+		}, {
+			name: "Seeded success and error cases",
+			args: args{
+				srcPath:       `testdata/test099.go`,
+				subtests:      true,
+				seedErrorCase: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/seeded_success_and_error_cases.go"),
+		}, {
+			name: "Seeded success and error cases in a map",
+			args: args{
+				srcPath:       `testdata/test099.go`,
+				subtests:      true,
+				seedErrorCase: true,
+				tableStyle:    "map",
+			},
+			want: mustReadFile(t, "testdata/goldens/seeded_success_and_error_cases_in_a_map.go"),
+		}, {
+			name: "Function with interface{} parameter and result",
+			args: args{
+				srcPath: `testdata/test021.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/function_with_interface_parameter_and_result.go"),
+		}, {
+			name: "Function with named imports",
+			args: args{
+				srcPath: `testdata/test022.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/function_with_named_imports.go"),
+		}, {
+			name: "Function with channel parameter and result",
+			args: args{
+				srcPath: `testdata/test023.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/function_with_channel_parameter_and_result.go"),
+		}, {
+			name: "File with multiple imports",
+			args: args{
+				srcPath: `testdata/test024.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/file_with_multiple_imports.go"),
+		}, {
+			name: "Function returning two results and an error",
+			args: args{
+				srcPath: `testdata/test025.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/function_returning_two_results_and_an_error.go"),
+		}, {
+			name: "Multiple named results",
+			args: args{
+				srcPath: `testdata/test026.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_named_results.go"),
+		}, {
+			name: "Two different structs with same method name",
+			args: args{
+				srcPath: `testdata/test027.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/two_different_structs_with_same_method_name.go"),
+		}, {
+			name: "Underlying types",
+			args: args{
+				srcPath: `testdata/test028.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/underlying_types.go"),
+		}, {
+			name: "Struct receiver with multiple fields",
+			args: args{
+				srcPath: `testdata/test029.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/struct_receiver_with_multiple_fields.go"),
+		}, {
+			name: "Struct receiver with anonymous fields",
+			args: args{
+				srcPath: `testdata/test030.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/struct_receiver_with_anonymous_fields.go"),
+		}, {
+			name: "io.Writer parameters",
+			args: args{
+				srcPath: `testdata/test031.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/io_writer_parameters.go"),
+		}, {
+			name: "Two structs with same method name",
+			args: args{
+				srcPath: `testdata/test032.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/two_structs_with_same_method_name.go"),
+		}, {
+			name: "Functions and methods with 'name' receivers, parameters, and results",
+			args: args{
+				srcPath: `testdata/test033.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/functions_and_methods_with_name_receivers_parameters_and_results.go"),
+		}, {
+			name: "Receiver struct with reserved field names",
+			args: args{
+				srcPath: `testdata/test034.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/receiver_struct_with_reserved_field_names.go"),
+		}, {
+			name: "Receiver struct with fields with complex package names",
+			args: args{
+				srcPath: `testdata/test035.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/receiver_struct_with_fields_with_complex_package_names.go"),
+		}, {
+			name: "Functions and receivers with same names except exporting",
+			args: args{
+				srcPath: `testdata/test036.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/functions_and_receivers_with_same_names_except_exporting.go"),
+		}, {
+			name: "Receiver is indirect imported struct",
+			args: args{
+				srcPath: `testdata/test037.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/receiver_is_indirect_imported_struct.go"),
+		}, {
+			name: "Multiple functions",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions.go"),
+		}, {
+			name: "Multiple functions with only",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+				only:    regexp.MustCompile("FooFilter|bazFilter"),
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_only.go"),
+		}, {
+			name: "Multiple functions with only regexp without matches",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+				only:    regexp.MustCompile("asdf"),
+			},
+			wantNoTests: true,
+		}, {
+			name: "Multiple functions with case-insensitive only",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+				only:    regexp.MustCompile("(?i)fooFilter|BazFilter"),
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_case-insensitive_only.go"),
+		}, {
+			name: "Multiple functions with only filtering on receiver",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+				only:    regexp.MustCompile("^BarBarFilter$"),
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_only_filtering_on_receiver.go"),
+		}, {
+			name: "Multiple functions with only filtering on method",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+				only:    regexp.MustCompile("^(BarFilter)$"),
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_only_filtering_on_method.go"),
+		}, {
+			name: "Multiple functions filtering exported",
+			args: args{
+				srcPath:  `testdata/test_filter.go`,
+				exported: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions_filtering_exported.go"),
+		}, {
+			name: "Multiple functions filtering exported with only",
+			args: args{
+				srcPath:  `testdata/test_filter.go`,
+				only:     regexp.MustCompile(`FooFilter`),
+				exported: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions_filtering_exported_with_only.go"),
+		}, {
+			name: "Multiple functions filtering all out",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+				only:    regexp.MustCompile("fooFilter"),
+			},
+			wantNoTests: true,
+		}, {
+			name: "Multiple functions with excl",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+				excl:    regexp.MustCompile("FooFilter|bazFilter"),
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_excl.go"),
+		}, {
+			name: "Multiple functions with case-insensitive excl",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+				excl:    regexp.MustCompile("(?i)foOFilter|BaZFilter"),
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_case-insensitive_excl.go"),
+		}, {
+			name: "Multiple functions filtering exported with excl",
+			args: args{
+				srcPath:  `testdata/test_filter.go`,
+				excl:     regexp.MustCompile(`FooFilter`),
+				exported: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions_filtering_exported_with_excl.go"),
+		}, {
+			name: "Multiple functions excluding all",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+				excl:    regexp.MustCompile("bazFilter|FooFilter|BarFilter"),
+			},
+			wantNoTests: true,
+		}, {
+			name: "Multiple functions excluding on receiver",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+				excl:    regexp.MustCompile("^BarBarFilter$"),
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions_excluding_on_receiver.go"),
+		}, {
+			name: "Multiple functions excluding on method",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+				excl:    regexp.MustCompile("^BarFilter$"),
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions_excluding_on_method.go"),
+		}, {
+			name: "Multiple functions with both only and excl",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+				only:    regexp.MustCompile("BarFilter"),
+				excl:    regexp.MustCompile("FooFilter"),
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_both_only_and_excl.go"),
+		}, {
+			name: "Multiple functions with only and excl competing",
+			args: args{
+				srcPath: `testdata/test_filter.go`,
+				only:    regexp.MustCompile("FooFilter|BarFilter"),
+				excl:    regexp.MustCompile("FooFilter|bazFilter"),
+			},
+			want: mustReadFile(t, "testdata/goldens/multiple_functions_with_only_and_excl_competing.go"),
+		}, {
+			name: "Custom importer fails",
+			args: args{
+				srcPath:  `testdata/test_filter.go`,
+				importer: &fakeImporter{err: errors.New("error")},
+			},
+			want: mustReadFile(t, "testdata/goldens/custom_importer_fails.go"),
+		}, {
+			name: "Existing test file",
+			args: args{
+				srcPath: `testdata/test100.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/existing_test_file.go"),
+		}, {
+			name: "Existing test file with just package declaration",
+			args: args{
+				srcPath: `testdata/test101.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/existing_test_file_with_just_package_declaration.go"),
+		}, {
+			name: "Existing test file with no functions",
+			args: args{
+				srcPath: `testdata/test102.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/existing_test_file_with_no_functions.go"),
+		}, {
+			name: "Existing test file with multiple imports",
+			args: args{
+				srcPath: `testdata/test200.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/existing_test_file_with_multiple_imports.go"),
+		}, {
+			name: "Entire testdata directory",
+			args: args{
+				srcPath: `testdata/`,
+			},
+			wantMultipleTests: true,
+		}, {
+			name: "Different packages in same directory - part 1",
+			args: args{
+				srcPath: `testdata/mixedpkg/bar.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/different_packages_in_same_directory_-_part_1.go"),
+		}, {
+			name: "Different packages in same directory - part 2",
+			args: args{
+				srcPath: `testdata/mixedpkg/foo.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/different_packages_in_same_directory_-_part_2.go"),
+		}, {
+			name: "Empty test file",
+			args: args{
+				srcPath: `testdata/blanktest/blank.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/empty_test_file.go"),
+		}, {
+			name: "Test file with syntax errors",
+			args: args{
+				srcPath: `testdata/syntaxtest/syntax.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/test_file_with_syntax_errors.go"),
+		}, {
+			name: "Undefined types",
+			args: args{
+				srcPath: `testdata/undefinedtypes/undefined.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/undefined_types.go"),
+		}, {
+			name: "Subtest Edition - Functions and receivers with same names except exporting",
+			args: args{
+				srcPath:  `testdata/test036.go`,
+				subtests: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/subtest_edition_-_functions_and_receivers_with_same_names_except_exporting.go"),
+		},
+		{
+			name: "Init function",
+			args: args{
+				srcPath: `testdata/init_func.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/no_init_funcs.go"),
+		},
+		{
+			name: "Existing test file with package level comments",
+			args: args{
+				srcPath: `testdata/test_existing_test_file_with_comments.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/existing_test_file_with_package_level_comments.go"),
+		},
+		{
+			name: "Existing test file with package level comments with newline",
+			args: args{
+				srcPath: `testdata/test_existing_test_file_with_comments.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/existing_test_file_with_package_level_comments.go"),
+		}, {
+			name: "Benchmarks for multiple functions",
+			args: args{
+				srcPath:    `testdata/test_filter.go`,
+				benchmarks: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/benchmarks_for_multiple_functions.go"),
+		}, {
+			name: "Benchmarks for struct receiver with multiple fields",
+			args: args{
+				srcPath:    `testdata/test029.go`,
+				benchmarks: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/benchmarks_for_struct_receiver_with_multiple_fields.go"),
+		}, {
+			name: "Fuzz targets for primitive parameters",
+			args: args{
+				srcPath: `testdata/test038.go`,
+				fuzz:    true,
+			},
+			want: mustReadFile(t, "testdata/goldens/fuzz_targets_for_primitive_parameters.go"),
+		}, {
+			name: "Generic functions and methods",
+			args: args{
+				srcPath: `testdata/test039.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/generic_functions_and_methods.go"),
+		}, {
+			name: "Compare results with cmp.Diff",
+			args: args{
+				srcPath: `testdata/test025.go`,
+				cmpDiff: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/compare_results_with_cmp_diff.go"),
+		}, {
+			name: "Compare results with cmp.Diff without results",
+			args: args{
+				srcPath: `testdata/test002.go`,
+				cmpDiff: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/compare_results_with_cmp_diff_without_results.go"),
+		}, {
+			name: "Merge with existing test file",
+			args: args{
+				srcPath: `testdata/test100.go`,
+				merge:   true,
+			},
+			want: mustReadFile(t, "testdata/goldens/merge_with_existing_test_file.go"),
+		}, {
+			name: "Merge with existing tests and benchmarks",
+			args: args{
+				srcPath:    `testdata/test040.go`,
+				benchmarks: true,
+				merge:      true,
+			},
+			want: mustReadFile(t, "testdata/goldens/merge_with_existing_tests_and_benchmarks.go"),
+		}, {
+			name: "Merge without existing test file",
+			args: args{
+				srcPath: `testdata/test025.go`,
+				cmpDiff: true,
+				merge:   true,
+			},
+			want: mustReadFile(t, "testdata/goldens/compare_results_with_cmp_diff.go"),
+		}, {
+			name: "External test package",
+			args: args{
+				srcPath:       `testdata/test041.go`,
+				external:      true,
+				forceExternal: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/external_test_package.go"),
+		}, {
+			name: "External test package with benchmarks and fuzz targets",
+			args: args{
+				srcPath:       `testdata/test041.go`,
+				only:          regexp.MustCompile("Mix41|Repeat41"),
+				external:      true,
+				forceExternal: true,
+				benchmarks:    true,
+				fuzz:          true,
+			},
+			want: mustReadFile(t, "testdata/goldens/external_test_package_with_benchmarks_and_fuzz_targets.go"),
+		}, {
+			name: "External tests of functions with unexported types",
+			args: args{
+				srcPath:       `testdata/test063.go`,
+				subtests:      true,
+				external:      true,
+				forceExternal: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/external_tests_of_functions_with_unexported_types.go"),
+		}, {
+			name: "External tests skipping functions with unexported types",
+			args: args{
+				srcPath:         `testdata/test063.go`,
+				subtests:        true,
+				external:        true,
+				forceExternal:   true,
+				skipUnexposable: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/external_tests_skipping_functions_with_unexported_types.go"),
+		}, {
+			name: "Imports left as rendered",
+			args: args{
+				srcPath:    `testdata/test008.go`,
+				rawImports: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/imports_left_as_rendered.go"),
+		}, {
+			name: "Parallel subtests",
+			args: args{
+				srcPath:   `testdata/test042.go`,
+				subtests:  true,
+				parallel:  true,
+				goVersion: "1.21",
+			},
+			want: mustReadFile(t, "testdata/goldens/parallel_subtests.go"),
+		}, {
+			name: "Parallel subtests for Go 1.22",
+			args: args{
+				srcPath:   `testdata/test042.go`,
+				subtests:  true,
+				parallel:  true,
+				goVersion: "1.22",
+			},
+			want: mustReadFile(t, "testdata/goldens/parallel_subtests_for_go_1_22.go"),
+		}, {
+			name: "Parallel without subtests",
+			args: args{
+				srcPath:  `testdata/test042.go`,
+				parallel: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/parallel_without_subtests.go"),
+		}, {
+			name: "Custom template directory",
+			args: args{
+				srcPath:     `testdata/test042.go`,
+				only:        regexp.MustCompile("Div42"),
+				templateDir: `testdata/templates/reflect`,
+			},
+			want: mustReadFile(t, "testdata/goldens/custom_template_directory.go"),
+		}, {
+			name: "Custom templates with template params",
+			args: args{
+				srcPath:     `testdata/test042.go`,
+				only:        regexp.MustCompile("Div42"),
+				templateDir: `testdata/templates/params`,
+				templateParams: map[string]interface{}{
+					"license": "Licensed under the MIT License.",
+					"timeout": "5 * time.Second",
+				},
+			},
+			want: mustReadFile(t, "testdata/goldens/custom_templates_with_template_params.go"),
+		}, {
+			name: "Custom templates with template funcs",
+			args: args{
+				srcPath:       `testdata/test042.go`,
+				only:          regexp.MustCompile("Div42"),
+				templateDir:   `testdata/templates/funcs`,
+				templateFuncs: template.FuncMap{"upper": strings.ToUpper},
+			},
+			want: mustReadFile(t, "testdata/goldens/custom_templates_with_template_funcs.go"),
+		}, {
+			name: "Context parameters filled in",
+			args: args{
+				srcPath:     `testdata/test043.go`,
+				printInputs: true,
+				benchmarks:  true,
+			},
+			want: mustReadFile(t, "testdata/goldens/context_parameters_filled_in.go"),
+		}, {
+			name: "Context parameters in args",
+			args: args{
+				srcPath:       `testdata/test043.go`,
+				noFillContext: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/context_parameters_in_args.go"),
+		}, {
+			name: "Context parameters with named context import",
+			args: args{
+				srcPath: `testdata/test044.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/context_parameters_with_named_context_import.go"),
+		}, {
+			name: "Mocks for interface parameters",
+			args: args{
+				srcPath:        `testdata/test045.go`,
+				benchmarks:     true,
+				mockInterfaces: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/mocks_for_interface_parameters.go"),
+		}, {
+			name: "Cleanup of closers",
+			args: args{
+				srcPath:  `testdata/test047.go`,
+				subtests: true,
+				cleanup:  true,
+			},
+			want: mustReadFile(t, "testdata/goldens/cleanup_of_closers.go"),
+		}, {
+			name: "Setup helpers for struct receivers",
+			args: args{
+				srcPath:  `testdata/test042.go`,
+				subtests: true,
+				helpers:  true,
+			},
+			want: mustReadFile(t, "testdata/goldens/setup_helpers_for_struct_receivers.go"),
+		}, {
+			name: "Custom case and args names",
+			args: args{
+				srcPath:        `testdata/test042.go`,
+				benchmarks:     true,
+				printInputs:    true,
+				caseVarName:    "cases",
+				argsStructName: "in",
+			},
+			want: mustReadFile(t, "testdata/goldens/custom_case_and_args_names.go"),
+		}, {
+			name: "Error comparison with bool",
+			args: args{
+				srcPath:         `testdata/test042.go`,
+				only:            regexp.MustCompile("Div42"),
+				errorComparison: "bool",
+			},
+			want: mustReadFile(t, "testdata/goldens/error_comparison_with_bool.go"),
+		}, {
+			name: "Error comparison with errors.Is",
+			args: args{
+				srcPath:         `testdata/test042.go`,
+				only:            regexp.MustCompile("Div42"),
+				errorComparison: "is",
+				rawImports:      true,
+			},
+			want: mustReadFile(t, "testdata/goldens/error_comparison_with_errors_is.go"),
+		}, {
+			name: "Error comparison with messages",
+			args: args{
+				srcPath:         `testdata/test042.go`,
+				only:            regexp.MustCompile("Div42"),
+				errorComparison: "message",
+				subtests:        true,
+			},
+			want: mustReadFile(t, "testdata/goldens/error_comparison_with_messages.go"),
+		}, {
+			name: "Examples in an external test package",
+			args: args{
+				srcPath:       `testdata/test041.go`,
+				external:      true,
+				forceExternal: true,
+				examples:      true,
+			},
+			want: mustReadFile(t, "testdata/goldens/examples_in_an_external_test_package.go"),
+		}, {
+			name: "Examples with zero value arguments",
+			args: args{
+				srcPath:       `testdata/test048.go`,
+				external:      true,
+				forceExternal: true,
+				examples:      true,
+			},
+			want: mustReadFile(t, "testdata/goldens/examples_with_zero_value_arguments.go"),
+		}, {
+			name: "Examples without an external test package",
+			args: args{
+				srcPath:  `testdata/test041.go`,
+				only:     regexp.MustCompile("Repeat41"),
+				examples: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/examples_without_an_external_test_package.go"),
+		}, {
+			name: "HTTP handlers",
+			args: args{
+				srcPath:      `testdata/test049.go`,
+				subtests:     true,
+				httpHandlers: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/http_handlers.go"),
+		}, {
+			name: "HTTP handlers without subtests or fixed imports",
+			args: args{
+				srcPath:      `testdata/test049.go`,
+				only:         regexp.MustCompile("Health49"),
+				httpHandlers: true,
+				rawImports:   true,
+			},
+			want: mustReadFile(t, "testdata/goldens/http_handlers_without_subtests_or_fixed_imports.go"),
+		}, {
+			name: "HTTP handlers without the option",
+			args: args{
+				srcPath: `testdata/test049.go`,
+				only:    regexp.MustCompile("Health49"),
+			},
+			want: mustReadFile(t, "testdata/goldens/http_handlers_without_the_option.go"),
+		}, {
+			name: "Doc comments copied to tests",
+			args: args{
+				srcPath: `testdata/test050.go`,
+				copyDoc: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/doc_comments_copied_to_tests.go"),
+		}, {
+			name: "Methods grouped by receiver",
+			args: args{
+				srcPath: `testdata/test051.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/methods_grouped_by_receiver.go"),
+		}, {
+			name: "Testify assertions",
+			args: args{
+				srcPath:   `testdata/test052.go`,
+				assertion: "testify",
+				subtests:  true,
+			},
+			want: mustReadFile(t, "testdata/goldens/testify_assertions.go"),
+		}, {
+			name: "Testify assertions without subtests",
+			args: args{
+				srcPath:     `testdata/test052.go`,
+				assertion:   "testify",
+				printInputs: true,
+			},
+			want: mustReadFile(t, "testdata/goldens/testify_assertions_without_subtests.go"),
+		}, {
+			name: "Testify assertions with errors.Is",
+			args: args{
+				srcPath:         `testdata/test052.go`,
+				only:            regexp.MustCompile("Parse52"),
+				assertion:       "testify",
+				errorComparison: "is",
+				subtests:        true,
+				allowError:      true,
+			},
+			want: mustReadFile(t, "testdata/goldens/testify_assertions_with_errors_is.go"),
+		}, {
+			name: "Build constraint for another platform",
+			args: args{
+				srcPath: `testdata/constraints/info_plan9.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/build_constraint_for_another_platform.go"),
+		}, {
+			name: "Build constraint for the current platform",
+			args: args{
+				srcPath: `testdata/constraints/info.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/build_constraint_for_the_current_platform.go"),
+		}, {
+			name: "Header comment above a build constraint",
+			args: args{
+				srcPath:       `testdata/constraints/info.go`,
+				headerComment: "// Copyright 2024 The Authors.\n// SPDX-License-Identifier: Apache-2.0\n",
+			},
+			want: mustReadFile(t, "testdata/goldens/header_comment_above_a_build_constraint.go"),
+		}, {
+			name: "Legacy build constraint",
+			args: args{
+				srcPath: `testdata/constraints/legacy.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/legacy_build_constraint.go"),
+		}, {
+			name: "Build tags",
+			args: args{
+				srcPath:   `testdata/test071.go`,
+				buildTags: []string{"integration", "e2e"},
+			},
+			want: mustReadFile(t, "testdata/goldens/build_tags.go"),
+		}, {
+			name: "Build tags with a build constraint",
+			args: args{
+				srcPath:   `testdata/constraints/legacy.go`,
+				buildTags: []string{"integration", "linux"},
+			},
+			want: mustReadFile(t, "testdata/goldens/build_tags_with_a_build_constraint.go"),
+		}, {
+			name: "Functions with skip directives",
+			args: args{
+				srcPath: `testdata/test046.go`,
+			},
+			want: mustReadFile(t, "testdata/goldens/functions_with_skip_directives.go"),
+		}, {
+			name: "Skip directives take precedence over only",
+			args: args{
+				srcPath: `testdata/test046.go`,
+				only:    regexp.MustCompile("Add46|generated46"),
+			},
+			wantNoTests: true,
+		}, {
+			name: "Custom template directory with invalid template",
+			args: args{
+				srcPath:     `testdata/test042.go`,
+				templateDir: `testdata/templates/invalid`,
+			},
+			wantNoTests: true,
+			wantErr:     true,
+		}, {
+			name: "Template funcs named after built-in helpers",
+			args: args{
+				srcPath:       `testdata/test042.go`,
+				templateDir:   `testdata/templates/funcs`,
+				templateFuncs: template.FuncMap{"upper": strings.ToUpper, "Param": strings.ToUpper},
+			},
+			wantNoTests: true,
+			wantErr:     true,
+		}, {
+			name: "Custom templates without their template funcs",
+			args: args{
+				srcPath:     `testdata/test042.go`,
+				templateDir: `testdata/templates/funcs`,
+			},
+			wantNoTests: true,
+			wantErr:     true,
+		}, {
+			name: "Nonexistent custom template directory",
+			args: args{
+				srcPath:     `testdata/test042.go`,
+				templateDir: `testdata/templates/nonexistent`,
+			},
+			wantNoTests: true,
+			wantErr:     true,
+		},
+	}
+	tmp, err := ioutil.TempDir("", "gotests_test")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir: %v", err)
+	}
+	for _, tt := range tests {
+		gts, err := GenerateTests(tt.args.srcPath, &Options{
+			Only:                tt.args.only,
+			Exclude:             tt.args.excl,
+			OnlyReceiver:        tt.args.onlyReceiver,
+			ExclReceiver:        tt.args.exclReceiver,
+			Exported:            tt.args.exported,
+			PrintInputs:         tt.args.printInputs,
+			Subtests:            tt.args.subtests,
+			Benchmarks:          tt.args.benchmarks,
+			BenchSizes:          tt.args.benchSizes,
+			Fuzz:                tt.args.fuzz,
+			CmpDiff:             tt.args.cmpDiff,
+			Merge:               tt.args.merge,
+			External:            tt.args.external,
+			SkipUnexposable:     tt.args.skipUnexposable,
+			EnvSetup:            tt.args.envSetup,
+			SortSlices:          tt.args.sortSlices,
+			TestNameTemplate:    tt.args.testName,
+			SkipNoResult:        tt.args.skipNoResult,
+			ForceInternal:       tt.args.forceInternal,
+			ForceExternal:       tt.args.forceExternal,
+			IncludeGenerated:    tt.args.includeGen,
+			CaseTimeout:         tt.args.caseTimeout,
+			NumberCases:         tt.args.numberCases,
+			DerefPointers:       tt.args.derefPointers,
+			CaptureStdout:       tt.args.captureStdout,
+			Cases:               tt.args.cases,
+			BuildTags:           tt.args.buildTags,
+			AsyncPattern:        tt.args.asyncPattern,
+			BoundaryCases:       tt.args.boundaryCases,
+			UseConstructors:     tt.args.useConstructors,
+			BoolAsError:         tt.args.boolAsError,
+			LeakCheck:           tt.args.leakCheck,
+			LeakCheckMain:       tt.args.leakCheckMain,
+			MultilineCases:      tt.args.multilineCases,
+			UseEqualMethod:      tt.args.useEqualMethod,
+			CoverageHints:       tt.args.coverageHints,
+			TestingPkg:          tt.args.testingPkg,
+			LintFriendly:        tt.args.lintFriendly,
+			SkipEmpty:           tt.args.skipEmpty,
+			ConcurrencyCase:     tt.args.concurrencyCase,
+			AutoName:            tt.args.autoName,
+			FieldDiff:           tt.args.fieldDiff,
+			GoVersion:           tt.args.goVersion,
+			TempDirForPaths:     tt.args.tempDirForPaths,
+			GroupByReceiver:     tt.args.groupByReceiver,
+			SeedErrorCase:       tt.args.seedErrorCase,
+			TemplateFuncs:       tt.args.templateFuncs,
+			NoFixImports:        tt.args.rawImports,
+			Parallel:            tt.args.parallel,
+			TemplateDir:         tt.args.templateDir,
+			TemplateParams:      tt.args.templateParams,
+			NoFillContext:       tt.args.noFillContext,
+			MockInterfaces:      tt.args.mockInterfaces,
+			Cleanup:             tt.args.cleanup,
+			Helpers:             tt.args.helpers,
+			ErrorComparison:     tt.args.errorComparison,
+			CaseVarName:         tt.args.caseVarName,
+			ArgsStructName:      tt.args.argsStructName,
+			Examples:            tt.args.examples,
+			HTTPHandlers:        tt.args.httpHandlers,
+			CopyDoc:             tt.args.copyDoc,
+			Assertion:           tt.args.assertion,
+			AllowError:          tt.args.allowError,
+			HeaderComment:       tt.args.headerComment,
+			VariadicCases:       tt.args.variadicCases,
+			ScaffoldComplexArgs: tt.args.scaffoldArgs,
+			Panics:              tt.args.panics,
+			TableStyle:          tt.args.tableStyle,
+			LoadPackages:        tt.args.loadPackages,
+			Golden:              tt.args.golden,
+			MessageFormat:       tt.args.messageFormat,
+			Importer:            func() types.Importer { return tt.args.importer },
+		})
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q. GenerateTests(%v) error = %v, wantErr %v", tt.name, tt.args.srcPath, err, tt.wantErr)
+			continue
+		}
+		if (len(gts) == 0) != tt.wantNoTests {
+			t.Errorf("%q. GenerateTests(%v) returned no tests", tt.name, tt.args.srcPath)
+			continue
+		}
+		if (len(gts) > 1) != tt.wantMultipleTests {
+			t.Errorf("%q. GenerateTests(%v) returned too many tests", tt.name, tt.args.srcPath)
+			continue
+		}
+		if tt.wantNoTests || tt.wantMultipleTests {
+			continue
+		}
+		if got := string(gts[0].Output); got != tt.want {
+			t.Errorf("%q. GenerateTests(%v) = \n%v, want \n%v", tt.name, tt.args.srcPath, got, tt.want)
+			outputResult(t, tmp, tt.name, gts[0].Output)
+		}
+	}
+}
+
+func TestGenerateTestsDeterministic(t *testing.T) {
+	for _, srcPath := range []string{"testdata/test051.go", "testdata/constraints"} {
+		var outputs [][]byte
+		for i := 0; i < 2; i++ {
+			gts, err := GenerateTests(srcPath, &Options{Subtests: true})
+			if err != nil {
+				t.Fatalf("GenerateTests(%v) error = %v", srcPath, err)
+			}
+			var b []byte
+			for _, gt := range gts {
+				b = append(b, gt.Path...)
+				b = append(b, gt.Output...)
+			}
+			outputs = append(outputs, b)
+		}
+		if string(outputs[0]) != string(outputs[1]) {
+			t.Errorf("GenerateTests(%v) = \n%s, then \n%s", srcPath, outputs[0], outputs[1])
+		}
+	}
+}
+
+func TestGenerateTestsLoadPackagesFallback(t *testing.T) {
+	// Outside of any module, the package can't be loaded by the go command.
+	dir := t.TempDir()
+	src := path.Join(dir, "p.go")
+	if err := ioutil.WriteFile(src, []byte("package p\n\nimport \"io\"\n\nfunc F(w io.Writer) int { return 0 }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gts, err := GenerateTests(src, &Options{LoadPackages: true, Subtests: true})
+	if err != nil {
+		t.Fatalf("GenerateTests() error = %v", err)
+	}
+	if len(gts) != 1 || !strings.Contains(string(gts[0].Output), "w := &bytes.Buffer{}") {
+		t.Errorf("GenerateTests() = %v, want a test of F with an io.Writer", gts)
+	}
+}
+
+func TestGenerateTestsGoldenUpdateFlag(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a", "b"} {
+		src := "package p\n\nfunc " + strings.ToUpper(name) + "() string { return \"\" }\n"
+		if err := ioutil.WriteFile(path.Join(dir, name+".go"), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, fixImports := range []bool{true, false} {
+		gts, err := GenerateTests(dir, &Options{Golden: true, Subtests: true, NoFixImports: !fixImports})
+		if err != nil {
+			t.Fatalf("GenerateTests() error = %v", err)
+		}
+		var n int
+		for _, gt := range gts {
+			n += strings.Count(string(gt.Output), `flag.Bool("update"`)
+		}
+		if len(gts) != 2 || n != 1 {
+			t.Errorf("GenerateTests() = %v tests declaring -update %v times, want 2 declaring it once", len(gts), n)
+		}
+	}
+}
+
+func TestGenerateTestsTestMain(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a", "b"} {
+		src := "package p\n\nfunc " + strings.ToUpper(name) + "() int { return 0 }\n"
+		if err := ioutil.WriteFile(path.Join(dir, name+".go"), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	count := func() int {
+		gts, err := GenerateTests(dir, &Options{GenerateTestMain: true, Subtests: true})
+		if err != nil {
+			t.Fatalf("GenerateTests() error = %v", err)
+		}
+		if len(gts) != 2 {
+			t.Fatalf("GenerateTests() = %v tests, want 2", len(gts))
+		}
+		var n int
+		for _, gt := range gts {
+			n += strings.Count(string(gt.Output), "func TestMain(m *testing.M)")
+		}
+		return n
+	}
+	if n := count(); n != 1 {
+		t.Errorf("GenerateTests() declared TestMain %v times, want once", n)
+	}
+	src := "package p\n\nimport \"testing\"\n\nfunc TestMain(m *testing.M) { m.Run() }\n"
+	if err := ioutil.WriteFile(path.Join(dir, "main_test.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if n := count(); n != 0 {
+		t.Errorf("GenerateTests() declared TestMain %v times besides main_test.go, want none", n)
+	}
+}
+
+func TestGenerateTestsSplitFiles(t *testing.T) {
+	gts, err := GenerateTests("testdata/test053.go", &Options{SplitFiles: true, Subtests: true})
+	if err != nil {
+		t.Fatalf("GenerateTests() error = %v", err)
+	}
+	want := []struct {
+		path, test string
+	}{
+		{"test053_bar53_2_test.go", "Test_bar53"},
+		{"test053_bar53_test.go", "TestBar53"},
+		{"test053_counter53_add_test.go", "TestCounter53_Add"},
+	}
+	if len(gts) != len(want) {
+		t.Fatalf("GenerateTests() returned %v tests, want %v", len(gts), len(want))
+	}
+	for i, gt := range gts {
+		if path.Base(gt.Path) != want[i].path {
+			t.Errorf("GenerateTests() Path = %v, want base %v", gt.Path, want[i].path)
+		}
+		if len(gt.Functions) != 1 || gt.Functions[0].TestName() != want[i].test {
+			t.Errorf("GenerateTests() test of %v = %v, want %v", gt.Path, gt.Functions, want[i].test)
+		}
+		if !strings.HasPrefix(string(gt.Output), "package testdata\n") || strings.Count(string(gt.Output), "func Test") != 1 {
+			t.Errorf("GenerateTests() %v = \n%s, want a package clause and one test", gt.Path, gt.Output)
+		}
+	}
+}
+
+func TestGenerateTestsPosition(t *testing.T) {
+	// A directory whose name looks like that of a file.
+	dotted := path.Join(t.TempDir(), "pkg.v2")
+	if err := os.Mkdir(dotted, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(dotted, "p.go"), []byte("package p; func F() int { return 0 }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		srcPath string
+		line    int
+		offset  int
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Line in the body",
+			srcPath: "testdata/test073.go",
+			line:    6,
+			want:    "TestMin73",
+		}, {
+			name:    "Line of the doc comment",
+			srcPath: "testdata/test073.go",
+			line:    13,
+			want:    "TestMax73",
+		}, {
+			name:    "Line of the closing brace",
+			srcPath: "testdata/test073.go",
+			line:    19,
+			want:    "TestMax73",
+		}, {
+			name:    "Offset of the name",
+			srcPath: "testdata/test073.go",
+			offset:  strings.Index(mustReadFile(t, "testdata/test073.go"), "Max73(a"),
+			want:    "TestMax73",
+		}, {
+			name:    "Line between the functions",
+			srcPath: "testdata/test073.go",
+			line:    11,
+			wantErr: true,
+		}, {
+			name:    "Line past the end",
+			srcPath: "testdata/test073.go",
+			line:    100,
+			wantErr: true,
+		}, {
+			name:    "Directory",
+			srcPath: "testdata/constraints",
+			line:    1,
+			wantErr: true,
+		}, {
+			name:    "Directory with a dot",
+			srcPath: dotted,
+			line:    1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		gts, err := GenerateTests(tt.srcPath, &Options{Line: tt.line, Offset: tt.offset, Subtests: true})
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q. GenerateTests() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if len(gts) != 1 || len(gts[0].Functions) != 1 || gts[0].Functions[0].TestName() != tt.want {
+			t.Errorf("%q. GenerateTests() = %v, want only %v", tt.name, gts, tt.want)
+		}
+	}
+}
+
+func TestGenerateTestsFromSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		src      string
+		opt      *Options
+		want     string
+		wantPath string
+		wantErr  bool
+	}{
+		{
+			name:     "Source with a function",
+			filename: "testdata/test025.go",
+			src:      mustReadFile(t, "testdata/test025.go"),
+			opt:      &Options{CmpDiff: true},
+			want:     mustReadFile(t, "testdata/goldens/compare_results_with_cmp_diff.go"),
+			wantPath: "testdata/test025_test.go",
+		}, {
+			name:     "Source with only filtering all out",
+			filename: "testdata/test025.go",
+			src:      mustReadFile(t, "testdata/test025.go"),
+			opt:      &Options{Only: regexp.MustCompile("asdf")},
+		}, {
+			name:     "Empty source",
+			filename: "empty.go",
+			wantErr:  true,
+		}, {
+			name:     "Source with syntax errors",
+			filename: "invalid.go",
+			src:      "package invalid\n\nfunc Foo( {}\n",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		gts, err := GenerateTestsFromSource(tt.filename, []byte(tt.src), tt.opt)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q. GenerateTestsFromSource(%v) error = %v, wantErr %v", tt.name, tt.filename, err, tt.wantErr)
+			continue
+		}
+		if tt.want == "" {
+			if len(gts) != 0 {
+				t.Errorf("%q. GenerateTestsFromSource(%v) returned %v tests, want none", tt.name, tt.filename, len(gts))
+			}
+			continue
+		}
+		if len(gts) != 1 {
+			t.Errorf("%q. GenerateTestsFromSource(%v) returned %v tests, want 1", tt.name, tt.filename, len(gts))
+			continue
+		}
+		if gts[0].Path != tt.wantPath {
+			t.Errorf("%q. GenerateTestsFromSource(%v) Path = %v, want %v", tt.name, tt.filename, gts[0].Path, tt.wantPath)
+		}
+		if got := string(gts[0].Output); got != tt.want {
+			t.Errorf("%q. GenerateTestsFromSource(%v) = \n%v, want \n%v", tt.name, tt.filename, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateTestsTransform(t *testing.T) {
+	comment := func(f *ast.File) error {
+		f.Comments = append(f.Comments, &ast.CommentGroup{List: []*ast.Comment{{Slash: f.End(), Text: "// Transformed."}}})
+		return nil
+	}
+	gts, err := GenerateTests("testdata/test099.go", &Options{Subtests: true, Transform: comment})
+	if err != nil {
+		t.Fatalf("GenerateTests() error = %v", err)
+	}
+	if len(gts) != 1 || !strings.HasSuffix(string(gts[0].Output), "} // Transformed.\n") {
+		t.Errorf("GenerateTests() = %v tests, want the tests ending with the comment", len(gts))
+	}
+	want := errors.New("transform failed")
+	_, err = GenerateTests("testdata/test099.go", &Options{Subtests: true, Transform: func(*ast.File) error { return want }})
+	if err == nil || !strings.Contains(err.Error(), "Transform: transform failed") {
+		t.Errorf("GenerateTests() error = %v, want the error of the transform", err)
+	}
+}
+
+func TestOutputOptions(t *testing.T) {
+	opt := &Options{}
+	v := reflect.ValueOf(opt).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		setNonZero(v.Field(i))
+	}
+	// Those are turned off by the NoFixImports and NoFillContext options.
+	off := map[string]bool{"FixImports": true, "FillContext": true}
+	var check func(v reflect.Value)
+	check = func(v reflect.Value) {
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.Anonymous {
+				check(v.Field(i))
+			} else if v.Field(i).IsZero() != off[f.Name] {
+				t.Errorf("outputOptions() %v = %v, want it set from the options", f.Name, v.Field(i))
+			}
+		}
+	}
+	check(reflect.ValueOf(outputOptions(opt, []string{"TestFoo"})).Elem())
+}
+
+// setNonZero sets v to a value other than the zero value of its type.
+func setNonZero(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.String:
+		v.SetString("x")
+	case reflect.Int, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+	case reflect.Func:
+		v.Set(reflect.MakeFunc(v.Type(), func([]reflect.Value) []reflect.Value {
+			var out []reflect.Value
+			for i := 0; i < v.Type().NumOut(); i++ {
+				out = append(out, reflect.Zero(v.Type().Out(i)))
+			}
+			return out
+		}))
 	}
 }
 
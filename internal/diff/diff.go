@@ -0,0 +1,156 @@
+// Package diff computes line-based unified diffs of generated test files.
+package diff
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// The number of unchanged lines shown around each change.
+const context = 3
+
+// An op is a line kept, deleted from a, or inserted from b.
+type op struct {
+	kind byte // ' ', '-', or '+'.
+	line string
+}
+
+// Unified returns the unified diff transforming a into b, where the files are
+// named oldName and newName in its header. It returns nil if a and b are
+// equal. An empty oldName denotes a new file, diffed against /dev/null.
+func Unified(oldName, newName string, a, b []byte) []byte {
+	if bytes.Equal(a, b) {
+		return nil
+	}
+	ops := edits(splitLines(a), splitLines(b))
+	out := &bytes.Buffer{}
+	if oldName == "" {
+		fmt.Fprintln(out, "--- /dev/null")
+	} else {
+		fmt.Fprintf(out, "--- a/%v\n", oldName)
+	}
+	fmt.Fprintf(out, "+++ b/%v\n", newName)
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		// Extend the hunk until the next change is more than twice the
+		// context away.
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for j := i; j < len(ops); j++ {
+			if ops[j].kind != ' ' {
+				end = j + 1
+			} else if j-end >= 2*context {
+				break
+			}
+		}
+		end += context
+		if end > len(ops) {
+			end = len(ops)
+		}
+		writeHunk(out, ops, start, end)
+		i = end
+	}
+	return out.Bytes()
+}
+
+// writeHunk writes the hunk of ops[start:end] to out.
+func writeHunk(out *bytes.Buffer, ops []op, start, end int) {
+	// The line numbers at the start of the hunk.
+	aLine, bLine := 1, 1
+	for _, o := range ops[:start] {
+		if o.kind != '+' {
+			aLine++
+		}
+		if o.kind != '-' {
+			bLine++
+		}
+	}
+	var aLen, bLen int
+	for _, o := range ops[start:end] {
+		if o.kind != '+' {
+			aLen++
+		}
+		if o.kind != '-' {
+			bLen++
+		}
+	}
+	if aLen == 0 {
+		aLine--
+	}
+	if bLen == 0 {
+		bLine--
+	}
+	fmt.Fprintf(out, "@@ -%v,%v +%v,%v @@\n", aLine, aLen, bLine, bLen)
+	for _, o := range ops[start:end] {
+		out.WriteByte(o.kind)
+		out.WriteString(o.line)
+		if len(o.line) == 0 || o.line[len(o.line)-1] != '\n' {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// edits returns the shortest edit script transforming a into b, computed
+// from their longest common subsequence.
+func edits(a, b []string) []op {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var ops []op
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, op{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, op{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, op{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, op{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, op{'+', b[j]})
+	}
+	return ops
+}
+
+// splitLines splits b into lines, keeping their line endings.
+func splitLines(b []byte) []string {
+	var lines []string
+	for len(b) > 0 {
+		i := bytes.IndexByte(b, '\n') + 1
+		if i == 0 {
+			i = len(b)
+		}
+		lines = append(lines, string(b[:i]))
+		b = b[i:]
+	}
+	return lines
+}
@@ -0,0 +1,31 @@
+// Package fields copies the options shared by the option structs of the
+// packages.
+package fields
+
+import "reflect"
+
+// Copy sets the exported fields of the struct that dst points to, and those
+// of the structs it embeds, to the fields of the same name and an assignable
+// type of the struct that src points to. The other fields are left as is.
+func Copy(dst, src interface{}) {
+	copyFields(reflect.ValueOf(dst).Elem(), reflect.ValueOf(src).Elem())
+}
+
+func copyFields(dst, src reflect.Value) {
+	t := dst.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			copyFields(dst.Field(i), src)
+			continue
+		}
+		sf, ok := src.Type().FieldByName(f.Name)
+		if !ok || sf.PkgPath != "" || !sf.Type.AssignableTo(f.Type) {
+			continue
+		}
+		dst.Field(i).Set(src.FieldByIndex(sf.Index))
+	}
+}
@@ -0,0 +1,68 @@
+package goparser
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
+// branches returns the conditions of the if, switch, and for statements of
+// body, in their order in the source, such as "if x > 0" or "for range xs",
+// with the cases of the switch statements, for the tests to cover.
+func branches(body *ast.BlockStmt) []string {
+	if body == nil {
+		return nil
+	}
+	var bs []string
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch s := n.(type) {
+		case *ast.IfStmt:
+			bs = append(bs, "if "+types.ExprString(s.Cond))
+		case *ast.SwitchStmt:
+			tag := "switch"
+			if s.Tag != nil {
+				tag += " " + types.ExprString(s.Tag)
+			}
+			bs = append(bs, tag+": "+clauses(s.Body))
+		case *ast.TypeSwitchStmt:
+			tag := "switch"
+			switch a := s.Assign.(type) {
+			case *ast.AssignStmt:
+				tag += " " + types.ExprString(a.Rhs[0])
+			case *ast.ExprStmt:
+				tag += " " + types.ExprString(a.X)
+			}
+			bs = append(bs, tag+": "+clauses(s.Body))
+		case *ast.ForStmt:
+			if s.Cond != nil {
+				bs = append(bs, "for "+types.ExprString(s.Cond))
+			}
+		case *ast.RangeStmt:
+			bs = append(bs, "for range "+types.ExprString(s.X))
+		}
+		return true
+	})
+	return bs
+}
+
+// clauses returns the cases of the switch statement body, separated by
+// semicolons.
+func clauses(body *ast.BlockStmt) string {
+	var cs []string
+	for _, s := range body.List {
+		cc, ok := s.(*ast.CaseClause)
+		if !ok {
+			continue
+		}
+		if cc.List == nil {
+			cs = append(cs, "default")
+			continue
+		}
+		var es []string
+		for _, e := range cc.List {
+			es = append(es, types.ExprString(e))
+		}
+		cs = append(cs, "case "+strings.Join(es, ", "))
+	}
+	return strings.Join(cs, "; ")
+}
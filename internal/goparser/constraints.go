@@ -0,0 +1,139 @@
+package goparser
+
+import (
+	"go/ast"
+	"go/build/constraint"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+var (
+	knownOS = list("aix android darwin dragonfly freebsd hurd illumos ios js linux nacl netbsd openbsd plan9 solaris wasip1 windows zos")
+	// The operating systems that satisfy the "unix" build tag.
+	unixOS    = list("aix android darwin dragonfly freebsd hurd illumos ios linux netbsd openbsd solaris")
+	knownArch = list("386 amd64 amd64p32 arm armbe arm64 arm64be loong64 mips mipsle mips64 mips64le mips64p32 mips64p32le ppc ppc64 ppc64le riscv riscv64 s390 s390x sparc sparc64 wasm")
+)
+
+func list(s string) []string {
+	return strings.Fields(s)
+}
+
+func contains(ss []string, s string) bool {
+	for _, e := range ss {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// buildConstraint returns the build constraint of f from its //go:build
+// line, or else its // +build lines. It returns nil if f has none.
+func buildConstraint(f *ast.File) constraint.Expr {
+	var plus constraint.Expr
+	for _, cg := range f.Comments {
+		if cg.Pos() >= f.Package {
+			break
+		}
+		for _, c := range cg.List {
+			x, err := constraint.Parse(c.Text)
+			if err != nil {
+				continue
+			}
+			if constraint.IsGoBuild(c.Text) {
+				return x
+			}
+			if plus == nil {
+				plus = x
+			} else {
+				plus = &constraint.AndExpr{X: plus, Y: x}
+			}
+		}
+	}
+	return plus
+}
+
+// A platform is a set of build tags that files are built for: an operating
+// system, an architecture, and the custom tags that are set.
+type platform struct {
+	goos, goarch string
+	tags         []string
+}
+
+// sourcePlatform returns a platform that the source file at srcPath with the
+// build constraint x is built for, preferring the current one, so that the
+// files of the package are type checked regardless of the active GOOS and
+// GOARCH. The custom tags x requires are set. It returns nil if there is no
+// such platform.
+func sourcePlatform(srcPath string, x constraint.Expr) *platform {
+	var tags []string
+	if x != nil {
+		tags = positiveTags(x)
+	}
+	p := &platform{goos: runtime.GOOS, goarch: runtime.GOARCH, tags: tags}
+	if p.matches(srcPath, x) {
+		return p
+	}
+	for _, goos := range knownOS {
+		for _, goarch := range knownArch {
+			p := &platform{goos: goos, goarch: goarch, tags: tags}
+			if p.matches(srcPath, x) {
+				return p
+			}
+		}
+	}
+	return nil
+}
+
+// positiveTags returns the tags of x that aren't negated.
+func positiveTags(x constraint.Expr) []string {
+	switch x := x.(type) {
+	case *constraint.TagExpr:
+		return []string{x.Tag}
+	case *constraint.AndExpr:
+		return append(positiveTags(x.X), positiveTags(x.Y)...)
+	case *constraint.OrExpr:
+		return append(positiveTags(x.X), positiveTags(x.Y)...)
+	}
+	return nil
+}
+
+// matches reports whether the file at path with the build constraint x,
+// which may be nil, is built for p.
+func (p *platform) matches(path string, x constraint.Expr) bool {
+	name := strings.TrimSuffix(strings.TrimSuffix(filepath.Base(path), ".go"), "_test")
+	// As with go build, only the suffixes after the first underscore count.
+	if i := strings.Index(name, "_"); i >= 0 {
+		l := strings.Split(name[i+1:], "_")
+		n := len(l)
+		if n >= 2 && contains(knownOS, l[n-2]) && contains(knownArch, l[n-1]) {
+			if !p.tag(l[n-2]) || !p.tag(l[n-1]) {
+				return false
+			}
+		} else if contains(knownOS, l[n-1]) || contains(knownArch, l[n-1]) {
+			if !p.tag(l[n-1]) {
+				return false
+			}
+		}
+	}
+	return x == nil || x.Eval(p.tag)
+}
+
+// tag reports whether the build tag t is satisfied by p.
+func (p *platform) tag(t string) bool {
+	switch {
+	case contains(knownOS, t):
+		return t == p.goos ||
+			t == "linux" && p.goos == "android" ||
+			t == "darwin" && p.goos == "ios" ||
+			t == "solaris" && p.goos == "illumos"
+	case t == "unix":
+		return contains(unixOS, p.goos)
+	case contains(knownArch, t):
+		return t == p.goarch
+	case t == "gc" || strings.HasPrefix(t, "go1."):
+		return true
+	}
+	return contains(p.tags, t)
+}
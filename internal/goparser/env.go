@@ -0,0 +1,63 @@
+package goparser
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// envVars returns the names of the environment variables that body reads with
+// os.Getenv or os.LookupEnv, in the order of their first read, where os is
+// the name the file imports the os package with. Only names that are string
+// literals are found.
+func envVars(body *ast.BlockStmt, os string) []string {
+	if body == nil || os == "" {
+		return nil
+	}
+	var names []string
+	seen := make(map[string]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Getenv" && sel.Sel.Name != "LookupEnv" {
+			return true
+		}
+		if id, ok := sel.X.(*ast.Ident); !ok || id.Name != os || id.Obj != nil {
+			// A local variable shadowing the package.
+			return true
+		}
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		if name, err := strconv.Unquote(lit.Value); err == nil && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+		return true
+	})
+	return names
+}
+
+// importName returns the name that f imports the package at path with, or ""
+// if it doesn't import it, or only for its side effects or into the file
+// block.
+func importName(f *ast.File, path string) string {
+	for _, imp := range f.Imports {
+		if p, err := strconv.Unquote(imp.Path.Value); err != nil || p != path {
+			continue
+		}
+		if imp.Name == nil {
+			return path[strings.LastIndex(path, "/")+1:]
+		}
+		if imp.Name.Name == "_" || imp.Name.Name == "." {
+			return ""
+		}
+		return imp.Name.Name
+	}
+	return ""
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"go/ast"
+	"go/build/constraint"
 	"go/parser"
 	"go/token"
 	"go/types"
@@ -30,6 +31,10 @@ type Result struct {
 type Parser struct {
 	// The importer to resolve packages from import paths.
 	Importer types.Importer
+	// Whether the tests are in an external test package. If so, the types
+	// declared by the package are qualified with its name and the functions
+	// that can't be referenced from outside the package are left out.
+	External bool
 }
 
 // Parse parses a given Go file at srcPath, along any files that share the same
@@ -39,26 +44,66 @@ func (p *Parser) Parse(srcPath string, files []models.Path) (*Result, error) {
 	if err != nil {
 		return nil, err
 	}
+	return p.ParseSource(srcPath, b, files)
+}
+
+// ParseSource is like Parse, but parses the Go source in b instead of reading
+// it from srcPath. If files is empty, the source is type checked on its own.
+func (p *Parser) ParseSource(srcPath string, b []byte, files []models.Path) (*Result, error) {
+	if len(b) == 0 {
+		return nil, ErrEmptyFile
+	}
 	fset := token.NewFileSet()
-	f, err := p.parseFile(fset, srcPath)
+	f, err := p.parseFile(fset, srcPath, b)
 	if err != nil {
 		return nil, err
 	}
-	fs, err := p.parseFiles(fset, f, files)
+	x := buildConstraint(f)
+	fs, err := p.parseFiles(fset, f, files, sourcePlatform(srcPath, x))
 	if err != nil {
 		return nil, err
 	}
+	if len(files) == 0 {
+		fs = []*ast.File{f}
+	}
+	var bc string
+	if x != nil {
+		bc = "//go:build " + x.String()
+	}
+	h := &models.Header{
+		BuildConstraint: bc,
+		Comments:        parseComment(f, f.Package),
+		Package:         f.Name.String(),
+		Imports:         parseImports(f.Imports),
+		Code:            goCode(b, f),
+	}
+	var aliases map[string]string
+	if p.External {
+		aliases = aliasPackageImport(h, f.Name.Name)
+	}
 	return &Result{
-		Header: &models.Header{
-			Comments: parseComment(f, f.Package),
-			Package:  f.Name.String(),
-			Imports:  parseImports(f.Imports),
-			Code:     goCode(b, f),
-		},
-		Funcs: p.parseFunctions(fset, f, fs),
+		Header: h,
+		Funcs:  p.parseFunctions(fset, f, fs, aliases),
 	}, nil
 }
 
+// FuncNames returns the names of the functions, but not the methods,
+// declared in the Go file at path, without type checking it. A file with
+// syntax errors gives the names declared before the first error.
+func FuncNames(path string) ([]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if f == nil {
+		return nil, err
+	}
+	var names []string
+	for _, d := range f.Decls {
+		if fDecl, ok := d.(*ast.FuncDecl); ok && fDecl.Recv == nil {
+			names = append(names, fDecl.Name.Name)
+		}
+	}
+	return names, nil
+}
+
 func (p *Parser) readFile(srcPath string) ([]byte, error) {
 	b, err := ioutil.ReadFile(srcPath)
 	if err != nil {
@@ -70,44 +115,335 @@ func (p *Parser) readFile(srcPath string) ([]byte, error) {
 	return b, nil
 }
 
-func (p *Parser) parseFile(fset *token.FileSet, srcPath string) (*ast.File, error) {
-	f, err := parser.ParseFile(fset, srcPath, nil, parser.ParseComments)
+func (p *Parser) parseFile(fset *token.FileSet, srcPath string, b []byte) (*ast.File, error) {
+	f, err := parser.ParseFile(fset, srcPath, b, parser.ParseComments)
 	if err != nil {
 		return nil, fmt.Errorf("target parser.ParseFile(): %v", err)
 	}
 	return f, nil
 }
 
-func (p *Parser) parseFiles(fset *token.FileSet, f *ast.File, files []models.Path) ([]*ast.File, error) {
+// parseFiles parses the files of f's package that are built for the
+// platform pl, or all of them if pl is nil.
+func (p *Parser) parseFiles(fset *token.FileSet, f *ast.File, files []models.Path, pl *platform) ([]*ast.File, error) {
 	pkg := f.Name.String()
 	var fs []*ast.File
 	for _, file := range files {
-		ff, err := parser.ParseFile(fset, string(file), nil, 0)
+		ff, err := parser.ParseFile(fset, string(file), nil, parser.ParseComments)
 		if err != nil {
 			return nil, fmt.Errorf("other file parser.ParseFile: %v", err)
 		}
 		if name := ff.Name.String(); name != pkg {
 			continue
 		}
+		if pl != nil && !pl.matches(string(file), buildConstraint(ff)) {
+			continue
+		}
 		fs = append(fs, ff)
 	}
 	return fs, nil
 }
 
-func (p *Parser) parseFunctions(fset *token.FileSet, f *ast.File, fs []*ast.File) []*models.Function {
-	ul, el := p.parseTypes(fset, fs)
+func (p *Parser) parseFunctions(fset *token.FileSet, f *ast.File, fs []*ast.File, aliases map[string]string) []*models.Function {
+	ul, el, cl, eq := p.parseTypes(fset, fs)
+	for t := range astClosers(append(fs, f)) {
+		cl[t] = true
+	}
+	for t := range astEqualers(append(fs, f)) {
+		eq[t] = true
+	}
+	ts := parseTypeSpecs(append(fs, f))
+	ns := parseNewFuncs(append(fs, f))
+	ofs := parseOptionFuncs(append(fs, f))
+	var cs map[string]string
+	if p.External {
+		cs = parseConstructors(append(fs, f))
+	}
+	os, fmtName := importName(f, "os"), importName(f, "fmt")
 	var funcs []*models.Function
 	for _, d := range f.Decls {
 		fDecl, ok := d.(*ast.FuncDecl)
-		if !ok {
+		if !ok || skipped(fDecl) {
 			continue
 		}
-		funcs = append(funcs, parseFunc(fDecl, ul, el))
+		fun := parseFunc(fDecl, ul, el, ts)
+		fun.EnvVars = envVars(fDecl.Body, os)
+		fun.PrintsStdout = printsStdout(fDecl.Body, fmtName, os)
+		fun.Branches = branches(fDecl.Body)
+		fun.Start, fun.End = span(fset, fDecl)
+		if v := fun.Variadic(); v != nil && strings.HasSuffix(v.Type.TypeName(), "Option") {
+			v.OptionFuncs = ofs[v.Type.Value]
+		}
+		if r := fun.Receiver; r != nil && ns[r.Type.Value] != nil {
+			r.Constructor = parseFunc(ns[r.Type.Value], ul, el, ts)
+		}
+		if len(fun.Results) > 0 {
+			t := fun.Results[0].Type
+			t.IsCloser = cl[t.String()]
+		}
+		for _, r := range fun.Results {
+			r.Type.HasEqual = eq[r.Type.String()]
+		}
+		if p.External {
+			fun.Requalify(aliases)
+			if !qualify(fun, f.Name.Name, ts, cs) {
+				continue
+			}
+		}
+		funcs = append(funcs, fun)
 	}
 	return funcs
 }
 
-func (p *Parser) parseTypes(fset *token.FileSet, fs []*ast.File) (map[string]types.Type, map[*types.Struct]ast.Expr) {
+// span returns the positions of the start of fDecl, or of its doc comment if
+// any, and of its end.
+func span(fset *token.FileSet, fDecl *ast.FuncDecl) (token.Position, token.Position) {
+	start := fDecl.Pos()
+	if fDecl.Doc != nil {
+		start = fDecl.Doc.Pos()
+	}
+	return fset.Position(start), fset.Position(fDecl.End())
+}
+
+// skipped reports whether fDecl's doc comment has a //gotests:skip directive.
+func skipped(fDecl *ast.FuncDecl) bool {
+	if fDecl.Doc == nil {
+		return false
+	}
+	for _, c := range fDecl.Doc.List {
+		if strings.TrimSpace(c.Text) == "//gotests:skip" {
+			return true
+		}
+	}
+	return false
+}
+
+// qualify qualifies the types declared by package pkg in the signature of
+// fun with the package name. It reports false if fun can't be referenced
+// from an external test package. Functions whose signature has unexported
+// types of pkg are marked Unexposable, and their parameters of such types
+// get the constructors in cs that return them.
+func qualify(fun *models.Function, pkg string, ts map[string]*ast.TypeSpec, cs map[string]string) bool {
+	if !fun.IsExported {
+		return false
+	}
+	m := make(map[string]string)
+	for name := range ts {
+		m[name] = pkg + "." + name
+	}
+	// Whether the types qualified since q last reset it are all exposed.
+	var ok bool
+	var qt func(t string) string
+	qt = func(t string) string {
+		if strings.HasPrefix(t, "...") {
+			return "..." + qt(t[3:])
+		}
+		e, err := parser.ParseExpr(t)
+		if err != nil {
+			return t
+		}
+		ast.Inspect(e, func(n ast.Node) bool {
+			switch v := n.(type) {
+			case *ast.SelectorExpr:
+				return false
+			case *ast.Field:
+				ast.Inspect(v.Type, func(n ast.Node) bool {
+					if id, isIdent := n.(*ast.Ident); isIdent && ts[id.Name] != nil && !id.IsExported() {
+						ok = false
+					}
+					return true
+				})
+				return false
+			case *ast.Ident:
+				if ts[v.Name] != nil && !v.IsExported() {
+					ok = false
+				}
+			}
+			return true
+		})
+		return types.ExprString(substitute(e, m))
+	}
+	// q qualifies the types of f, reporting whether they're exposed.
+	q := func(f *models.Field) bool {
+		t := f.Type.String()
+		ok = true
+		f.Type.Value = qt(f.Type.Value)
+		for _, m := range f.Type.Methods {
+			for i := range m.Params {
+				m.Params[i] = qt(m.Params[i])
+			}
+			for i := range m.Results {
+				m.Results[i] = qt(m.Results[i])
+			}
+		}
+		// Nor can the unexported fields of a struct be compared.
+		for _, sf := range f.Type.Fields {
+			if !ast.IsExported(sf.Name) {
+				f.Type.Fields = nil
+				break
+			}
+		}
+		if !ok {
+			f.Unexposed = true
+			if c, found := cs[t]; found {
+				f.Constructor = pkg + "." + c
+			}
+		}
+		return ok
+	}
+	if v := fun.Variadic(); v != nil {
+		for i, o := range v.OptionFuncs {
+			v.OptionFuncs[i] = pkg + "." + o
+		}
+	}
+	if r := fun.Receiver; r != nil {
+		if !ast.IsExported(r.Type.TypeName()) || !q(r.Field) {
+			return false
+		}
+		// Unexported fields, and the fields of unexported types, can't be set
+		// from an external test package.
+		var fs []*models.Field
+		for _, f := range r.Fields {
+			if ast.IsExported(f.Name) && q(f) {
+				fs = append(fs, f)
+			}
+		}
+		r.Fields = fs
+		// Nor can a constructor taking parameters of unexported types be called.
+		if c := r.Constructor; c != nil {
+			for _, f := range c.Parameters {
+				if !q(f) {
+					r.Constructor = nil
+					break
+				}
+			}
+			if r.Constructor != nil {
+				c.Qualifier = pkg
+			}
+		}
+	}
+	for _, fs := range [][]*models.Field{fun.TypeParams, fun.Parameters, fun.Results} {
+		for _, f := range fs {
+			if !q(f) {
+				fun.Unexposable = true
+			}
+		}
+	}
+	fun.Qualifier = pkg
+	return true
+}
+
+// aliasPackageImport aliases the import of h that the file refers to by the
+// name pkg of its own package, which qualifies the types of the package in
+// external test packages, and returns the alias by the name it replaces.
+func aliasPackageImport(h *models.Header, pkg string) map[string]string {
+	for _, imp := range h.Imports {
+		if imp.Ident() == pkg {
+			return map[string]string{pkg: h.Alias(imp)}
+		}
+	}
+	return nil
+}
+
+// parseConstructors returns the exported functions without parameters that
+// return a single value, by the type of the value, in order to construct the
+// values of unexported types in external test packages.
+func parseConstructors(fs []*ast.File) map[string]string {
+	cs := make(map[string]string)
+	for _, f := range fs {
+		for _, d := range f.Decls {
+			fDecl, ok := d.(*ast.FuncDecl)
+			if !ok || fDecl.Recv != nil || !fDecl.Name.IsExported() || fDecl.Type.TypeParams != nil {
+				continue
+			}
+			res := fDecl.Type.Results
+			if len(fDecl.Type.Params.List) > 0 || res == nil || len(res.List) != 1 || len(res.List[0].Names) > 1 {
+				continue
+			}
+			t := types.ExprString(res.List[0].Type)
+			if _, found := cs[t]; !found {
+				cs[t] = fDecl.Name.Name
+			}
+		}
+	}
+	return cs
+}
+
+// parseOptionFuncs returns the names of the exported functions named With
+// followed by a name, which return only a value of a type of functional
+// options, by the type.
+func parseOptionFuncs(fs []*ast.File) map[string][]string {
+	os := make(map[string][]string)
+	// The source file may be among fs too.
+	seen := make(map[string]bool)
+	for _, f := range fs {
+		for _, d := range f.Decls {
+			fDecl, ok := d.(*ast.FuncDecl)
+			if !ok || fDecl.Recv != nil || fDecl.Type.TypeParams != nil || !strings.HasPrefix(fDecl.Name.Name, "With") || fDecl.Name.Name == "With" {
+				continue
+			}
+			res := fDecl.Type.Results
+			if res == nil || len(res.List) != 1 || len(res.List[0].Names) > 1 {
+				continue
+			}
+			t := types.ExprString(res.List[0].Type)
+			if !seen[fDecl.Name.Name] {
+				seen[fDecl.Name.Name] = true
+				os[t] = append(os[t], fDecl.Name.Name)
+			}
+		}
+	}
+	return os
+}
+
+// parseNewFuncs returns the functions named New followed by the name of a
+// type of the package, which return only that type or a pointer to it, by
+// the name of the type, in order to construct the receivers of its methods.
+func parseNewFuncs(fs []*ast.File) map[string]*ast.FuncDecl {
+	ns := make(map[string]*ast.FuncDecl)
+	for _, f := range fs {
+		for _, d := range f.Decls {
+			fDecl, ok := d.(*ast.FuncDecl)
+			if !ok || fDecl.Recv != nil || fDecl.Type.TypeParams != nil || !strings.HasPrefix(fDecl.Name.Name, "New") {
+				continue
+			}
+			res := fDecl.Type.Results
+			if res == nil || len(res.List) != 1 || len(res.List[0].Names) > 1 {
+				continue
+			}
+			t := res.List[0].Type
+			if s, ok := t.(*ast.StarExpr); ok {
+				t = s.X
+			}
+			if id, ok := t.(*ast.Ident); ok && id.Name == strings.TrimPrefix(fDecl.Name.Name, "New") {
+				ns[id.Name] = fDecl
+			}
+		}
+	}
+	return ns
+}
+
+// parseTypeSpecs collects the package level type declarations by name, in
+// order to resolve the type parameters of generic receivers and constraints.
+func parseTypeSpecs(fs []*ast.File) map[string]*ast.TypeSpec {
+	ts := make(map[string]*ast.TypeSpec)
+	for _, f := range fs {
+		for _, d := range f.Decls {
+			gDecl, ok := d.(*ast.GenDecl)
+			if !ok || gDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, s := range gDecl.Specs {
+				if s, ok := s.(*ast.TypeSpec); ok {
+					ts[s.Name.Name] = s
+				}
+			}
+		}
+	}
+	return ts
+}
+
+func (p *Parser) parseTypes(fset *token.FileSet, fs []*ast.File) (map[string]types.Type, map[*types.Struct]ast.Expr, map[string]bool, map[string]bool) {
 	conf := &types.Config{
 		Importer: p.Importer,
 		// Adding a NO-OP error function ignores errors and performs best-effort
@@ -121,15 +457,105 @@ func (p *Parser) parseTypes(fset *token.FileSet, fs []*ast.File) (map[string]typ
 	conf.Check("", fset, fs, ti)
 	ul := make(map[string]types.Type)
 	el := make(map[*types.Struct]ast.Expr)
+	cl := make(map[string]bool)
+	eq := make(map[string]bool)
 	for e, t := range ti.Types {
-		// Collect the underlying types.
+		// Collect the types with a Close() error method.
+		if isCloser(t.Type) {
+			cl[types.ExprString(e)] = true
+		}
+		// And those with an Equal(T) bool method.
+		if isEqualer(t.Type) {
+			eq[types.ExprString(e)] = true
+		}
+		// Collect the underlying types, also by the expressions of the types
+		// in the source, which refer to the imported ones by package name
+		// rather than path.
 		ul[t.Type.String()] = t.Type.Underlying()
+		if t.IsType() {
+			ul[types.ExprString(e)] = t.Type.Underlying()
+		}
 		// Collect structs to determine the fields of a receiver.
 		if v, ok := t.Type.(*types.Struct); ok {
 			el[v] = e
 		}
 	}
-	return ul, el
+	return ul, el, cl, eq
+}
+
+// isCloser reports whether t has a Close() error method.
+func isCloser(t types.Type) bool {
+	sel := types.NewMethodSet(t).Lookup(nil, "Close")
+	if sel == nil {
+		return false
+	}
+	sig, ok := sel.Type().(*types.Signature)
+	return ok && sig.Params().Len() == 0 && sig.Results().Len() == 1 &&
+		sig.Results().At(0).Type().String() == "error"
+}
+
+// isEqualer reports whether t has an Equal method taking a t and returning a
+// bool, such as time.Time.
+func isEqualer(t types.Type) bool {
+	sel := types.NewMethodSet(t).Lookup(nil, "Equal")
+	if sel == nil {
+		return false
+	}
+	sig, ok := sel.Type().(*types.Signature)
+	return ok && sig.Params().Len() == 1 && sig.Results().Len() == 1 &&
+		types.Identical(sig.Params().At(0).Type(), t) &&
+		sig.Results().At(0).Type().String() == "bool"
+}
+
+// astEqualers returns the local types, or pointers to them, with an
+// Equal(T) bool method declared in fs, where T is the type, for when their
+// type information isn't available.
+func astEqualers(fs []*ast.File) map[string]bool {
+	eq := make(map[string]bool)
+	for _, f := range fs {
+		for _, d := range f.Decls {
+			fDecl, ok := d.(*ast.FuncDecl)
+			if !ok || fDecl.Recv == nil || len(fDecl.Recv.List) != 1 || fDecl.Name.Name != "Equal" {
+				continue
+			}
+			ft := fDecl.Type
+			if ft.Params.NumFields() != 1 || ft.Results.NumFields() != 1 || types.ExprString(ft.Results.List[0].Type) != "bool" {
+				continue
+			}
+			recv := types.ExprString(fDecl.Recv.List[0].Type)
+			param := types.ExprString(ft.Params.List[0].Type)
+			if param == strings.TrimPrefix(recv, "*") || param == "*"+strings.TrimPrefix(recv, "*") {
+				eq[param] = true
+			}
+		}
+	}
+	return eq
+}
+
+// astClosers returns the local types with a Close() error method declared in
+// fs, for when their type information isn't available.
+func astClosers(fs []*ast.File) map[string]bool {
+	cl := make(map[string]bool)
+	for _, f := range fs {
+		for _, d := range f.Decls {
+			fDecl, ok := d.(*ast.FuncDecl)
+			if !ok || fDecl.Recv == nil || len(fDecl.Recv.List) != 1 || fDecl.Name.Name != "Close" {
+				continue
+			}
+			ft := fDecl.Type
+			if ft.Params.NumFields() != 0 || ft.Results.NumFields() != 1 || types.ExprString(ft.Results.List[0].Type) != "error" {
+				continue
+			}
+			switch r := fDecl.Recv.List[0].Type.(type) {
+			case *ast.Ident:
+				cl[r.Name] = true
+				cl["*"+r.Name] = true
+			case *ast.StarExpr:
+				cl[types.ExprString(r)] = true
+			}
+		}
+	}
+	return cl
 }
 
 func parseComment(f *ast.File, pkgPos token.Pos) []string {
@@ -145,6 +571,10 @@ func parseComment(f *ast.File, pkgPos token.Pos) []string {
 					comments = append(comments, strings.Repeat("\n", n))
 					count++ // for last of '\n'
 				}
+				if constraint.IsGoBuild(c.Text) {
+					// Rendered as the header's build constraint.
+					continue
+				}
 				comments = append(comments, c.Text)
 			}
 		}
@@ -166,14 +596,23 @@ func goCode(b []byte, f *ast.File) []byte {
 	return b[furthestPos:]
 }
 
-func parseFunc(fDecl *ast.FuncDecl, ul map[string]types.Type, el map[*types.Struct]ast.Expr) *models.Function {
+func parseFunc(fDecl *ast.FuncDecl, ul map[string]types.Type, el map[*types.Struct]ast.Expr, ts map[string]*ast.TypeSpec) *models.Function {
+	tps := instantiateTypeParams(fDecl.Type.TypeParams, ts)
+	m := typeArgs(tps)
+	recv, rm := parseReceiver(fDecl.Recv, ul, el, ts)
+	for k, v := range rm {
+		m[k] = v
+	}
 	f := &models.Function{
 		Name:       fDecl.Name.String(),
 		IsExported: fDecl.Name.IsExported(),
-		Receiver:   parseReceiver(fDecl.Recv, ul, el),
-		Parameters: parseFieldList(fDecl.Type.Params, ul),
+		Receiver:   recv,
+		TypeParams: tps,
+		Parameters: parseFieldList(instantiate(fDecl.Type.Params, m), ul),
+		Doc:        fDecl.Doc.Text(),
+		Pos:        fDecl.Pos(),
 	}
-	fs := parseFieldList(fDecl.Type.Results, ul)
+	fs := parseFieldList(instantiate(fDecl.Type.Results, m), ul)
 	i := 0
 	for _, fi := range fs {
 		if fi.Type.String() == "error" {
@@ -184,9 +623,32 @@ func parseFunc(fDecl *ast.FuncDecl, ul map[string]types.Type, el map[*types.Stru
 		f.Results = append(f.Results, fi)
 		i++
 	}
+	unnameClashingResults(f)
 	return f
 }
 
+// unnameClashingResults drops the names of the results of f that would give
+// their want and got fields the same name as another result's, or as the
+// wantErr and wantErrMsg fields of the error, so they're named after their
+// position instead.
+func unnameClashingResults(f *models.Function) {
+	seen := map[string]bool{}
+	if f.ReturnsError {
+		seen["Err"], seen["ErrMsg"] = true, true
+	}
+	for _, r := range f.Results {
+		if !r.IsNamed() {
+			continue
+		}
+		n := strings.Title(r.Name)
+		if seen[n] {
+			r.Name = ""
+			continue
+		}
+		seen[n] = true
+	}
+}
+
 func parseImports(imps []*ast.ImportSpec) []*models.Import {
 	var is []*models.Import
 	for _, imp := range imps {
@@ -202,31 +664,221 @@ func parseImports(imps []*ast.ImportSpec) []*models.Import {
 	return is
 }
 
-func parseReceiver(fl *ast.FieldList, ul map[string]types.Type, el map[*types.Struct]ast.Expr) *models.Receiver {
+// parseReceiver parses the method receiver. For receivers of generic types,
+// it also returns the type arguments chosen for the receiver's type
+// parameters, keyed by the names used in the method declaration.
+func parseReceiver(fl *ast.FieldList, ul map[string]types.Type, el map[*types.Struct]ast.Expr, ts map[string]*ast.TypeSpec) (*models.Receiver, map[string]string) {
 	if fl == nil {
-		return nil
+		return nil, nil
+	}
+	name, params := receiverTypeParams(fl.List[0].Type)
+	if spec, ok := ts[name]; ok && len(params) > 0 && spec.TypeParams != nil {
+		return parseGenericReceiver(fl, spec, params, ul, ts)
 	}
 	r := &models.Receiver{
 		Field: parseFieldList(fl, ul)[0],
 	}
 	t, ok := ul[r.Type.Value]
 	if !ok {
-		return r
+		return r, nil
 	}
 	s, ok := t.(*types.Struct)
 	if !ok {
-		return r
+		return r, nil
 	}
 	st, found := el[s]
 	if !found {
-		return r
+		return r, nil
 	}
 	r.Fields = append(r.Fields, parseFieldList(st.(*ast.StructType).Fields, ul)...)
 	for i, f := range r.Fields {
 		f.Name = s.Field(i).Name()
 	}
-	return r
+	return r, nil
+}
+
+// parseGenericReceiver parses a receiver of a generic type, instantiated with
+// the same type arguments as its type parameters' constraints dictate. The
+// fields are read from the type declaration, since the type checker only
+// knows them in terms of the uninstantiated type parameters.
+func parseGenericReceiver(fl *ast.FieldList, spec *ast.TypeSpec, params []string, ul map[string]types.Type, ts map[string]*ast.TypeSpec) (*models.Receiver, map[string]string) {
+	targs := instantiateTypeParams(spec.TypeParams, ts)
+	m := make(map[string]string)
+	for i, p := range params {
+		if i < len(targs) {
+			m[p] = targs[i].Type.Value
+		}
+	}
+	r := &models.Receiver{
+		Field: parseFieldList(instantiate(fl, m), ul)[0],
+	}
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return r, m
+	}
+	r.Type.Underlying = types.ExprString(st)
+	r.Fields = parseFieldList(instantiate(st.Fields, typeArgs(targs)), ul)
+	for _, f := range r.Fields {
+		if !f.IsNamed() {
+			f.Name = embeddedName(f.Type.Value)
+		}
+	}
+	return r, m
+}
+
+// embeddedName returns the implicit field name of an embedded type.
+func embeddedName(t string) string {
+	if i := strings.Index(t, "["); i > 0 {
+		t = t[:i]
+	}
+	if i := strings.LastIndex(t, "."); i >= 0 {
+		t = t[i+1:]
+	}
+	return t
+}
+
+// receiverTypeParams returns the type name of a receiver along with the
+// names it gives to the type parameters of a generic type.
+func receiverTypeParams(e ast.Expr) (string, []string) {
+	if s, ok := e.(*ast.StarExpr); ok {
+		e = s.X
+	}
+	var idx []ast.Expr
+	switch v := e.(type) {
+	case *ast.IndexExpr:
+		e, idx = v.X, []ast.Expr{v.Index}
+	case *ast.IndexListExpr:
+		e, idx = v.X, v.Indices
+	}
+	var params []string
+	for _, i := range idx {
+		params = append(params, types.ExprString(i))
+	}
+	return types.ExprString(e), params
+}
+
+// instantiateTypeParams chooses a concrete type argument for each type
+// parameter so that generated call sites compile: int for any and comparable,
+// and the first type of a union constraint.
+func instantiateTypeParams(fl *ast.FieldList, ts map[string]*ast.TypeSpec) []*models.Field {
+	if fl == nil {
+		return nil
+	}
+	var tps []*models.Field
+	for _, f := range fl.List {
+		t := types.ExprString(constraintType(f.Type, ts, 0))
+		for _, n := range f.Names {
+			tps = append(tps, &models.Field{
+				Name:  n.Name,
+				Type:  &models.Expression{Value: t},
+				Index: len(tps),
+			})
+		}
+	}
+	// Constraints may refer to other type parameters, e.g. [S ~[]E, E any].
+	m := typeArgs(tps)
+	for _, tp := range tps {
+		if e, err := parser.ParseExpr(tp.Type.Value); err == nil {
+			tp.Type.Value = types.ExprString(substitute(e, m))
+		}
+	}
+	return tps
+}
+
+func constraintType(e ast.Expr, ts map[string]*ast.TypeSpec, depth int) ast.Expr {
+	const maxDepth = 10
+	if depth > maxDepth {
+		return ast.NewIdent("int")
+	}
+	switch v := e.(type) {
+	case *ast.Ident:
+		if v.Name == "any" || v.Name == "comparable" {
+			return ast.NewIdent("int")
+		}
+		if s, ok := ts[v.Name]; ok {
+			if _, ok := s.Type.(*ast.InterfaceType); ok {
+				return constraintType(s.Type, ts, depth+1)
+			}
+		}
+		return v
+	case *ast.UnaryExpr:
+		if v.Op == token.TILDE {
+			return v.X
+		}
+	case *ast.BinaryExpr:
+		if v.Op == token.OR {
+			return constraintType(v.X, ts, depth+1)
+		}
+	case *ast.ParenExpr:
+		return constraintType(v.X, ts, depth+1)
+	case *ast.InterfaceType:
+		for _, m := range v.Methods.List {
+			if len(m.Names) == 0 {
+				return constraintType(m.Type, ts, depth+1)
+			}
+		}
+		return ast.NewIdent("int")
+	case *ast.SelectorExpr:
+		// Imported constraints such as constraints.Ordered can't be resolved
+		// without type checking, so fall back to a common numeric type.
+		return ast.NewIdent("int")
+	}
+	return e
+}
+
+func typeArgs(tps []*models.Field) map[string]string {
+	m := make(map[string]string)
+	for _, tp := range tps {
+		m[tp.Name] = tp.Type.Value
+	}
+	return m
+}
+
+// instantiate returns a copy of the field list with its type parameters
+// replaced by the given type arguments.
+func instantiate(fl *ast.FieldList, m map[string]string) *ast.FieldList {
+	if fl == nil || len(m) == 0 {
+		return fl
+	}
+	cp := &ast.FieldList{}
+	for _, f := range fl.List {
+		cp.List = append(cp.List, &ast.Field{
+			Names: f.Names,
+			Type:  substituteType(f.Type, m),
+		})
+	}
+	return cp
+}
+
+func substituteType(e ast.Expr, m map[string]string) ast.Expr {
+	if v, ok := e.(*ast.Ellipsis); ok {
+		return &ast.Ellipsis{Elt: substituteType(v.Elt, m)}
+	}
+	cp, err := parser.ParseExpr(types.ExprString(e))
+	if err != nil {
+		return e
+	}
+	return substitute(cp, m)
+}
 
+// substitute renames the identifiers of type parameters in e in place.
+func substitute(e ast.Expr, m map[string]string) ast.Expr {
+	ast.Inspect(e, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.SelectorExpr:
+			// Qualified identifiers never refer to type parameters.
+			return false
+		case *ast.Field:
+			substitute(v.Type, m)
+			return false
+		case *ast.Ident:
+			if t, ok := m[v.Name]; ok {
+				v.Name = t
+			}
+		}
+		return true
+	})
+	return e
 }
 
 func parseFieldList(fl *ast.FieldList, ul map[string]types.Type) []*models.Field {
@@ -285,8 +937,69 @@ func parseExpr(e ast.Expr, ul map[string]types.Type) *models.Expression {
 			Value:      val,
 			Underlying: underlying(val, ul),
 			IsWriter:   val == "io.Writer",
+			Methods:    methods(ul[val]),
+			Fields:     structFields(ul[val]),
+		}
+	}
+}
+
+// methods returns the methods of t if it's an interface type that can be
+// implemented outside its package.
+func methods(t types.Type) []*models.Method {
+	it, ok := t.(*types.Interface)
+	if !ok {
+		return nil
+	}
+	// Refer to the other packages by name, as the sources do.
+	qf := func(p *types.Package) string {
+		if p.Path() == "" {
+			return ""
 		}
+		return p.Name()
 	}
+	var ms []*models.Method
+	for i := 0; i < it.NumMethods(); i++ {
+		fn := it.Method(i)
+		if !fn.Exported() {
+			return nil
+		}
+		sig := fn.Type().(*types.Signature)
+		m := &models.Method{Name: fn.Name()}
+		for j := 0; j < sig.Params().Len(); j++ {
+			pt := sig.Params().At(j).Type()
+			if sig.Variadic() && j == sig.Params().Len()-1 {
+				m.Params = append(m.Params, "..."+types.TypeString(pt.(*types.Slice).Elem(), qf))
+				continue
+			}
+			m.Params = append(m.Params, types.TypeString(pt, qf))
+		}
+		for j := 0; j < sig.Results().Len(); j++ {
+			m.Results = append(m.Results, types.TypeString(sig.Results().At(j).Type(), qf))
+		}
+		ms = append(ms, m)
+	}
+	return ms
+}
+
+// structFields returns the fields of t if it's a struct type whose fields
+// can all be referred to from the package, unlike those of time.Time.
+func structFields(t types.Type) []*models.StructField {
+	st, ok := t.(*types.Struct)
+	if !ok {
+		return nil
+	}
+	var fs []*models.StructField
+	for i := 0; i < st.NumFields(); i++ {
+		v := st.Field(i)
+		if !v.Exported() && v.Pkg() != nil && v.Pkg().Path() != "" {
+			return nil
+		}
+		fs = append(fs, &models.StructField{
+			Name:       v.Name(),
+			Comparable: types.Comparable(v.Type()) && !types.IsInterface(v.Type()),
+		})
+	}
+	return fs
 }
 
 func underlying(val string, ul map[string]types.Type) string {
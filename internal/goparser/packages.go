@@ -0,0 +1,47 @@
+package goparser
+
+import (
+	"fmt"
+	"go/types"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// PackageImporter loads the package in dir with go/packages, and returns a
+// function returning importers of the types of its imports, as the go
+// command resolves them in the module or GOPATH of dir. The imports it
+// couldn't load are left to the importers returned by fallback. It returns
+// an error if the go command fails, in which case callers should use
+// fallback as is.
+func PackageImporter(dir string, fallback func() types.Importer) (func() types.Importer, error) {
+	// The dependencies are type checked from source too, rather than read
+	// from export data whose format depends on the version of the go command.
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.LoadSyntax | packages.NeedDeps, Dir: dir}, ".")
+	if err != nil {
+		return nil, fmt.Errorf("packages.Load: %v", err)
+	}
+	imports := make(map[string]*types.Package)
+	for _, pkg := range pkgs {
+		for path, imp := range pkg.Imports {
+			if imp.Types != nil && imp.Types.Complete() {
+				imports[path] = imp.Types
+			}
+		}
+	}
+	return func() types.Importer {
+		return &packageImporter{imports: imports, fallback: fallback()}
+	}, nil
+}
+
+// A packageImporter imports the packages loaded by PackageImporter.
+type packageImporter struct {
+	imports  map[string]*types.Package
+	fallback types.Importer
+}
+
+func (im *packageImporter) Import(path string) (*types.Package, error) {
+	if pkg, ok := im.imports[path]; ok {
+		return pkg, nil
+	}
+	return im.fallback.Import(path)
+}
@@ -0,0 +1,32 @@
+package goparser
+
+import "go/ast"
+
+// printsStdout reports whether body prints to standard output, with
+// fmt.Print, fmt.Printf, or fmt.Println, or by referencing os.Stdout, where
+// fmt and os are the names the file imports the packages with.
+func printsStdout(body *ast.BlockStmt, fmt, os string) bool {
+	if body == nil || fmt == "" && os == "" {
+		return false
+	}
+	var prints bool
+	ast.Inspect(body, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok || prints {
+			return !prints
+		}
+		id, ok := sel.X.(*ast.Ident)
+		if !ok || id.Obj != nil {
+			// A local variable shadowing the package.
+			return true
+		}
+		switch {
+		case id.Name == fmt && fmt != "":
+			prints = sel.Sel.Name == "Print" || sel.Sel.Name == "Printf" || sel.Sel.Name == "Println"
+		case id.Name == os && os != "":
+			prints = sel.Sel.Name == "Stdout"
+		}
+		return !prints
+	})
+	return prints
+}
@@ -1,9 +1,15 @@
 package input
 
 import (
+	"bufio"
 	"fmt"
+	"go/build"
+	"io/ioutil"
+	"os"
 	"path"
 	"path/filepath"
+	"regexp"
+	"strings"
 
 	"github.com/cweill/gotests/internal/models"
 )
@@ -47,3 +53,73 @@ func file(srcPath string) ([]models.Path, error) {
 func isHiddenFile(path string) bool {
 	return []rune(filepath.Base(path))[0] == '.'
 }
+
+// Returns the import path of the package in the given directory, derived from
+// the nearest enclosing go.mod, or else from the GOPATH.
+func ImportPath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("filepath.Abs: %v\n", err)
+	}
+	for d := dir; ; d = filepath.Dir(d) {
+		if mod := modulePath(filepath.Join(d, "go.mod")); mod != "" {
+			rel, err := filepath.Rel(d, dir)
+			if err != nil {
+				return "", fmt.Errorf("filepath.Rel: %v\n", err)
+			}
+			return path.Join(mod, filepath.ToSlash(rel)), nil
+		}
+		if filepath.Dir(d) == d {
+			break
+		}
+	}
+	for _, gopath := range filepath.SplitList(build.Default.GOPATH) {
+		src := filepath.Join(gopath, "src") + string(filepath.Separator)
+		if strings.HasPrefix(dir, src) {
+			return filepath.ToSlash(strings.TrimPrefix(dir, src)), nil
+		}
+	}
+	return "", fmt.Errorf("no module or GOPATH contains %v", dir)
+}
+
+// Returns the module path declared by the go.mod file at the given path, or
+// the empty string if there is none.
+func modulePath(gomod string) string {
+	b, err := ioutil.ReadFile(gomod)
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		f := strings.Fields(line)
+		if len(f) == 2 && f[0] == "module" {
+			return strings.Trim(f[1], "\"`")
+		}
+	}
+	return ""
+}
+
+// The comment marking generated Go files, as documented by go help generate.
+var generatedComment = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// Reports whether the Go file at the given path is generated, from the
+// comment marking generated files on one of its lines before the package
+// clause. Files that can't be read are reported as not generated, leaving
+// the error to their parsing.
+func IsGenerated(srcPath string) bool {
+	f, err := os.Open(srcPath)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSuffix(s.Text(), "\r")
+		if generatedComment.MatchString(line) {
+			return true
+		}
+		if strings.HasPrefix(line, "package ") {
+			break
+		}
+	}
+	return false
+}
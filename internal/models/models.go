@@ -1,6 +1,13 @@
 package models
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
 	"unicode"
 )
@@ -10,7 +17,28 @@ type Expression struct {
 	IsStar     bool
 	IsVariadic bool
 	IsWriter   bool
+	IsContext  bool
+	IsMock     bool
+	IsCloser   bool
+	HasEqual   bool
 	Underlying string
+	Methods    []*Method
+	// The fields of the struct type, if it is one.
+	Fields []*StructField
+}
+
+// A StructField is a field of a struct type.
+type StructField struct {
+	Name string
+	// Whether its values can be compared with ==, unlike slices, maps,
+	// functions, and interfaces, whose dynamic values may not be.
+	Comparable bool
+}
+
+type Method struct {
+	Name    string
+	Params  []string
+	Results []string
 }
 
 func (e *Expression) String() string {
@@ -24,16 +52,59 @@ func (e *Expression) String() string {
 	return value
 }
 
+func (e *Expression) TypeName() string {
+	t := e.Value
+	if i := strings.Index(t, "["); i > 0 {
+		t = t[:i]
+	}
+	if i := strings.LastIndex(t, "."); i >= 0 {
+		t = t[i+1:]
+	}
+	return t
+}
+
 type Field struct {
 	Name  string
 	Type  *Expression
 	Index int
+	// Whether the type has unexported types of the package, which an
+	// external test package can't reference, and the qualified name of the
+	// function without parameters returning a value of it, if any.
+	Unexposed   bool
+	Constructor string
+	// Whether the field is the trailing bool result of a function without
+	// an error, which the tests check as the success of the call.
+	IsOk bool
+	// The qualified names of the With functions of the package returning
+	// the element type of a variadic parameter of functional options, such
+	// as WithTimeout for ...Option.
+	OptionFuncs []string
 }
 
 func (f *Field) IsWriter() bool {
 	return f.Type.IsWriter
 }
 
+func (f *Field) IsContext() bool {
+	return f.Type.IsContext
+}
+
+// IsMock reports whether the tests pass a mock for f. Only interfaces with a
+// single method are mocked.
+func (f *Field) IsMock() bool {
+	return f.Type.IsMock && len(f.Type.Methods) == 1
+}
+
+func (f *Field) IsMockable() bool {
+	t := f.Type
+	return len(t.Methods) > 0 && !t.IsWriter && !t.IsStar && !t.IsVariadic && t.Value != "error"
+}
+
+func (f *Field) MockName() string {
+	n := []rune(f.Type.TypeName())
+	return "mock" + string(unicode.ToUpper(n[0])) + string(n[1:])
+}
+
 func (f *Field) IsStruct() bool {
 	return strings.HasPrefix(f.Type.Underlying, "struct")
 }
@@ -53,32 +124,269 @@ func isBasicType(t string) bool {
 	}
 }
 
+// IsSlice reports whether f's type is a slice, other than []byte.
+func (f *Field) IsSlice() bool {
+	return f.sliceType() != "" && f.sliceType() != "[]byte"
+}
+
+// OrderedElem returns the element type of f's slice type if its elements
+// are ordered with <, or "" if they aren't.
+func (f *Field) OrderedElem() string {
+	if !f.IsSlice() {
+		return ""
+	}
+	e := strings.TrimPrefix(f.sliceType(), "[]")
+	if !isBasicType(e) || e == "bool" || strings.HasPrefix(e, "complex") {
+		return ""
+	}
+	return e
+}
+
+// NumberType returns f's integer or floating-point type, or its underlying
+// type for named types, such as int64, or "" if it isn't one. Pointers,
+// variadic parameters, uintptr, and complex numbers aren't.
+func (f *Field) NumberType() string {
+	t := f.Type
+	if t.IsStar || t.IsVariadic {
+		return ""
+	}
+	u := t.Underlying
+	if u == "" {
+		u = t.Value
+	}
+	switch u {
+	case "int", "int8", "int16", "int32", "int64", "rune",
+		"uint", "uint8", "uint16", "uint32", "uint64", "byte",
+		"float32", "float64":
+		return u
+	}
+	return ""
+}
+
+// ChanElem returns the element type of f's type, or of its underlying type
+// for named channel types, if it's a channel that can be received from, or
+// else "".
+func (f *Field) ChanElem() string {
+	t := f.Type
+	if t.IsStar || t.IsVariadic {
+		return ""
+	}
+	for _, s := range []string{t.String(), t.Underlying} {
+		for _, prefix := range []string{"<-chan ", "chan "} {
+			if strings.HasPrefix(s, prefix) {
+				return strings.TrimPrefix(s, prefix)
+			}
+		}
+	}
+	return ""
+}
+
+// IterElem returns the type of the values f yields if it's an iter.Seq, or a
+// struct of its keys and values if it's an iter.Seq2, or "" if it's neither.
+func (f *Field) IterElem() string {
+	t := f.Type
+	if t.IsStar || t.IsVariadic {
+		return ""
+	}
+	if e := strings.TrimPrefix(t.Value, "iter.Seq["); e != t.Value {
+		return strings.TrimSuffix(e, "]")
+	}
+	if kv := iterPair(t.Value); kv != nil {
+		return "struct{ K " + kv[0] + "; V " + kv[1] + " }"
+	}
+	return ""
+}
+
+// IsSeq2 reports whether f is an iter.Seq2, which yields keys and values.
+func (f *Field) IsSeq2() bool {
+	return !f.Type.IsStar && !f.Type.IsVariadic && iterPair(f.Type.Value) != nil
+}
+
+// iterPair returns the key and value types of an iter.Seq2 type, or nil.
+func iterPair(s string) []string {
+	e := strings.TrimPrefix(s, "iter.Seq2[")
+	if e == s {
+		return nil
+	}
+	e = strings.TrimSuffix(e, "]")
+	depth := 0
+	for i, r := range e {
+		switch r {
+		case '[', '(', '{':
+			depth++
+		case ']', ')', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				return []string{strings.TrimSpace(e[:i]), strings.TrimSpace(e[i+1:])}
+			}
+		}
+	}
+	return nil
+}
+
+// sliceType returns f's type, or its underlying type for named slice types,
+// if it's a slice, or else "".
+func (f *Field) sliceType() string {
+	t := f.Type
+	if t.IsStar {
+		return ""
+	}
+	for _, s := range []string{t.String(), t.Underlying} {
+		if strings.HasPrefix(s, "[]") {
+			return s
+		}
+	}
+	return ""
+}
+
+// TempPath returns how the tests set up f in a temporary directory if it
+// looks like a path, from its name or type: "dir" for the string parameters
+// named dir or ending in Dir, such as outDir, "file" for those named path or
+// file or ending in Path or File, such as srcPath, and "os.File" for *os.File
+// parameters. It returns "" for the others.
+func (f *Field) TempPath() string {
+	t := f.Type
+	if t.IsVariadic {
+		return ""
+	}
+	if t.IsStar {
+		if t.Value == "os.File" {
+			return "os.File"
+		}
+		return ""
+	}
+	if t.Value != "string" {
+		return ""
+	}
+	switch n := f.Name; {
+	case n == "dir" || strings.HasSuffix(n, "Dir"):
+		return "dir"
+	case n == "path" || n == "file" || strings.HasSuffix(n, "Path") || strings.HasSuffix(n, "File"):
+		return "file"
+	}
+	return ""
+}
+
+func (f *Field) IsFuzzable() bool {
+	switch f.Type.String() {
+	case "string", "[]byte", "bool", "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "byte", "rune",
+		"float32", "float64":
+		return true
+	default:
+		return false
+	}
+}
+
 func (f *Field) IsNamed() bool {
 	return f.Name != "" && f.Name != "_"
 }
 
 func (f *Field) ShortName() string {
-	return strings.ToLower(string([]rune(f.Type.Value)[0]))
+	return strings.ToLower(string([]rune(f.Type.TypeName())[0]))
 }
 
 type Receiver struct {
 	*Field
 	Fields []*Field
+	// The New<Type> function of the package that returns the receiver's
+	// type, if any.
+	Constructor *Function
+}
+
+// Zero returns an expression of a valid zero receiver, such as T{} for
+// structs, T(0) for numeric types, or new(T) for pointers to them. Pointers
+// to composite types are allocated as &T{}.
+func (r *Receiver) Zero() string {
+	t, u := r.Type.Value, r.Type.Underlying
+	composite := r.IsStruct() || strings.HasPrefix(u, "[") || strings.HasPrefix(u, "map[")
+	if r.Type.IsStar {
+		if composite {
+			return "&" + t + "{}"
+		}
+		return "new(" + t + ")"
+	}
+	switch {
+	case u == "":
+		// The type couldn't be resolved.
+		return t + "{}"
+	case composite && !strings.HasPrefix(u, "[]") && !strings.HasPrefix(u, "map["):
+		return t + "{}"
+	case u == "string":
+		return t + `("")`
+	case u == "bool":
+		return t + "(false)"
+	case isBasicType(u):
+		return t + "(0)"
+	default:
+		return t + "(nil)"
+	}
 }
 
 type Function struct {
 	Name         string
 	IsExported   bool
+	Qualifier    string
 	Receiver     *Receiver
+	TypeParams   []*Field
 	Parameters   []*Field
 	Results      []*Field
 	ReturnsError bool
+	Doc          string
+	Pos          token.Pos // Position of the declaration in its source file.
+	// Positions of the start of the declaration, or of its doc comment if
+	// any, and of its end.
+	Start, End token.Position
+	// Whether the signature has unexported types, so that an external test
+	// package can only call the function with the arguments it can
+	// construct, without a table of test cases.
+	Unexposable bool
+	// The environment variables that the body reads with os.Getenv or
+	// os.LookupEnv.
+	EnvVars []string
+	// Whether the body prints to standard output, with the fmt.Print
+	// functions or os.Stdout.
+	PrintsStdout bool
+	// The conditions of the if, switch, and for statements of the body,
+	// such as "if x > 0", which the tests should cover.
+	Branches []string
+	// The name of the test, such as from a template, instead of the default
+	// of TestName.
+	CustomTestName string
+}
+
+// SortFunctions sorts funcs by the position of their declaration, with the
+// methods of each receiver type grouped at the position of its first one.
+func SortFunctions(funcs []*Function) {
+	first := make(map[string]token.Pos)
+	for _, f := range funcs {
+		if f.Receiver == nil {
+			continue
+		}
+		n := f.Receiver.Type.TypeName()
+		if p, ok := first[n]; !ok || f.Pos < p {
+			first[n] = f.Pos
+		}
+	}
+	group := func(f *Function) token.Pos {
+		if f.Receiver != nil {
+			return first[f.Receiver.Type.TypeName()]
+		}
+		return f.Pos
+	}
+	sort.SliceStable(funcs, func(i, j int) bool {
+		if gi, gj := group(funcs[i]), group(funcs[j]); gi != gj {
+			return gi < gj
+		}
+		return funcs[i].Pos < funcs[j].Pos
+	})
 }
 
 func (f *Function) TestParameters() []*Field {
 	var ps []*Field
 	for _, p := range f.Parameters {
-		if p.IsWriter() {
+		if p.IsWriter() || p.IsContext() || p.IsMock() {
 			continue
 		}
 		ps = append(ps, p)
@@ -86,6 +394,18 @@ func (f *Function) TestParameters() []*Field {
 	return ps
 }
 
+// SizeParameter returns the first test parameter of f that is an int or a
+// slice, whose size the sub-benchmarks of f vary, or nil if it has none.
+func (f *Function) SizeParameter() *Field {
+	for _, p := range f.TestParameters() {
+		t := p.Type
+		if t.String() == "int" || strings.HasPrefix(t.String(), "[]") || !t.IsStar && strings.HasPrefix(t.Underlying, "[]") {
+			return p
+		}
+	}
+	return nil
+}
+
 func (f *Function) TestResults() []*Field {
 	var ps []*Field
 	ps = append(ps, f.Results...)
@@ -106,10 +426,35 @@ func (f *Function) TestResults() []*Field {
 	return ps
 }
 
+// OkResult returns the trailing bool result of f, which may report the
+// success of the call in place of an error, or nil if f returns an error or
+// its last result isn't a bool.
+func (f *Function) OkResult() *Field {
+	n := len(f.Results)
+	if f.ReturnsError || n == 0 {
+		return nil
+	}
+	if r := f.Results[n-1]; r.Type.String() == "bool" {
+		return r
+	}
+	return nil
+}
+
 func (f *Function) ReturnsMultiple() bool {
 	return len(f.Results) > 1
 }
 
+// ReturnsChan reports whether any of f's results is a channel that can be
+// received from.
+func (f *Function) ReturnsChan() bool {
+	for _, r := range f.Results {
+		if r.ChanElem() != "" {
+			return true
+		}
+	}
+	return false
+}
+
 func (f *Function) OnlyReturnsOneValue() bool {
 	return len(f.Results) == 1 && !f.ReturnsError
 }
@@ -118,44 +463,241 @@ func (f *Function) OnlyReturnsError() bool {
 	return len(f.Results) == 0 && f.ReturnsError
 }
 
+// ReturnsText reports whether f's only result is a string or []byte, with or
+// without an error, that tests may compare against a golden file.
+func (f *Function) ReturnsText() bool {
+	if len(f.Results) != 1 || len(f.TestResults()) != 1 {
+		return false
+	}
+	t := f.Results[0].Type.String()
+	return t == "string" || t == "[]byte"
+}
+
 func (f *Function) FullName() string {
 	var r string
 	if f.Receiver != nil {
-		r = f.Receiver.Type.Value
+		r = f.Receiver.Type.TypeName()
 	}
 	return strings.Title(r) + strings.Title(f.Name)
 }
 
 func (f *Function) TestName() string {
-	if strings.HasPrefix(f.Name, "Test") {
+	if f.CustomTestName != "" {
+		return f.CustomTestName
+	}
+	return f.prefixedName("Test")
+}
+
+func (f *Function) ExampleName() string {
+	return f.prefixedName("Example")
+}
+
+func (f *Function) BenchmarkName() string {
+	return f.prefixedName("Benchmark")
+}
+
+func (f *Function) FuzzName() string {
+	return f.prefixedName("Fuzz")
+}
+
+func (f *Function) prefixedName(prefix string) string {
+	if strings.HasPrefix(f.Name, prefix) {
 		return f.Name
 	}
 	if f.Receiver != nil {
-		receiverType := f.Receiver.Type.Value
+		receiverType := f.Receiver.Type.TypeName()
 		if unicode.IsLower([]rune(receiverType)[0]) {
 			receiverType = "_" + receiverType
 		}
-		return "Test" + receiverType + "_" + f.Name
+		return prefix + receiverType + "_" + f.Name
 	}
 	if unicode.IsLower([]rune(f.Name)[0]) {
-		return "Test_" + f.Name
+		return prefix + "_" + f.Name
+	}
+	return prefix + f.Name
+}
+
+func (f *Function) HasInputs() bool {
+	if len(f.TestParameters()) > 0 {
+		return true
+	}
+	return f.Receiver != nil && (!f.Receiver.IsStruct() || len(f.Receiver.Fields) > 0)
+}
+
+func (f *Function) IsFuzzable() bool {
+	if len(f.Parameters) == 0 {
+		return false
+	}
+	for _, p := range f.Parameters {
+		if !p.IsFuzzable() {
+			return false
+		}
+	}
+	return true
+}
+
+// Variadic returns f's variadic parameter, or nil if it has none.
+func (f *Function) Variadic() *Field {
+	if n := len(f.Parameters); n > 0 && f.Parameters[n-1].Type.IsVariadic {
+		return f.Parameters[n-1]
+	}
+	return nil
+}
+
+// IsHTTPHandler reports whether f has the signature of an http.HandlerFunc.
+func (f *Function) IsHTTPHandler() bool {
+	return len(f.Parameters) == 2 && len(f.Results) == 0 && !f.ReturnsError &&
+		f.Parameters[0].Type.String() == "http.ResponseWriter" &&
+		f.Parameters[1].Type.String() == "*http.Request"
+}
+
+// Constructible reports whether an external test package can construct the
+// arguments of f: those of unexported types have a Constructor.
+func (f *Function) Constructible() bool {
+	for _, p := range f.TypeParams {
+		if p.Unexposed {
+			return false
+		}
 	}
-	return "Test" + f.Name
+	for _, p := range f.Parameters {
+		if p.Unexposed && p.Constructor == "" {
+			return false
+		}
+	}
+	return true
 }
 
 func (f *Function) IsNaked() bool {
 	return f.Receiver == nil && len(f.Parameters) == 0 && len(f.Results) == 0
 }
 
+// Requalify renames the package names qualifying the types in the signature
+// of f, its Qualifier, and the constructors of its parameters, to their
+// values in names.
+func (f *Function) Requalify(names map[string]string) {
+	if len(names) == 0 {
+		return
+	}
+	if n, ok := names[f.Qualifier]; ok {
+		f.Qualifier = n
+	}
+	fs := append(append(append([]*Field{}, f.TypeParams...), f.Parameters...), f.Results...)
+	if r := f.Receiver; r != nil {
+		fs = append(append(fs, r.Field), r.Fields...)
+		if r.Constructor != nil {
+			r.Constructor.Requalify(names)
+		}
+	}
+	for _, fi := range fs {
+		fi.Type.Value = requalify(fi.Type.Value, names)
+		for _, m := range fi.Type.Methods {
+			for i := range m.Params {
+				m.Params[i] = requalify(m.Params[i], names)
+			}
+			for i := range m.Results {
+				m.Results[i] = requalify(m.Results[i], names)
+			}
+		}
+		if fi.Constructor != "" {
+			fi.Constructor = requalify(fi.Constructor, names)
+		}
+		for i := range fi.OptionFuncs {
+			fi.OptionFuncs[i] = requalify(fi.OptionFuncs[i], names)
+		}
+	}
+}
+
+// requalify renames the package names qualifying the identifiers of the
+// expression t to their values in names. Expressions without any are left
+// as they're formatted.
+func requalify(t string, names map[string]string) string {
+	if strings.HasPrefix(t, "...") {
+		return "..." + requalify(t[3:], names)
+	}
+	e, err := parser.ParseExpr(t)
+	if err != nil {
+		return t
+	}
+	var renamed bool
+	ast.Inspect(e, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if id, ok := sel.X.(*ast.Ident); ok {
+			if n, ok := names[id.Name]; ok {
+				id.Name = n
+				renamed = true
+			}
+		}
+		return true
+	})
+	if !renamed {
+		return t
+	}
+	return types.ExprString(e)
+}
+
 type Import struct {
 	Name, Path string
+	// Whether code other than the signatures of the functions, such as that
+	// of the templates or an existing test file, refers to the package by
+	// its name, so that the import can't be aliased.
+	Fixed bool
+}
+
+// Ident returns the name the package is referred to by: the Name of the
+// import, or else the last element of its Path, without a major version
+// suffix such as /v2 or .v3.
+func (imp *Import) Ident() string {
+	if imp.Name != "" {
+		return imp.Name
+	}
+	p, err := strconv.Unquote(imp.Path)
+	if err != nil {
+		p = imp.Path
+	}
+	base := path.Base(p)
+	if strings.HasPrefix(base, "v") && isMajorVersion(base[1:]) && path.Dir(p) != "." {
+		base = path.Base(path.Dir(p))
+	}
+	if i := strings.LastIndex(base, ".v"); i > 0 && isMajorVersion(base[i+2:]) {
+		base = base[:i]
+	}
+	return base
+}
+
+// isMajorVersion reports whether s is the number of a major version from 2.
+func isMajorVersion(s string) bool {
+	n, err := strconv.Atoi(s)
+	return err == nil && n >= 2 && strconv.Itoa(n) == s
 }
 
 type Header struct {
-	Comments []string
-	Package  string
-	Imports  []*Import
-	Code     []byte
+	Banner          string // Comment rendered first, such as a license header.
+	BuildConstraint string
+	Comments        []string
+	Package         string
+	Imports         []*Import
+	Code            []byte
+	Existing        bool // Whether it's that of an existing test file.
+}
+
+// Alias names imp, one of the imports of h, after the name it's referred to
+// by and the smallest number from 1 that no import of h is named, such as
+// config1, and returns the new name.
+func (h *Header) Alias(imp *Import) string {
+	taken := make(map[string]bool)
+	for _, i := range h.Imports {
+		taken[i.Ident()] = true
+	}
+	name := imp.Ident()
+	for n := 1; ; n++ {
+		if a := name + strconv.Itoa(n); !taken[a] {
+			imp.Name = a
+			return a
+		}
+	}
 }
 
 type Path string
@@ -4,10 +4,23 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
 
+	"golang.org/x/tools/go/ast/astutil"
 	"golang.org/x/tools/imports"
 
 	"github.com/cweill/gotests/internal/models"
@@ -15,43 +28,891 @@ import (
 )
 
 type Options struct {
-	PrintInputs bool
-	Subtests    bool
-	AllowError  bool
+	render.TestOptions
+	Benchmarks      bool
+	BenchSizes      []int
+	Fuzz            bool
+	FixImports      bool
+	FillContext     bool
+	MockInterfaces  bool
+	BoolAsError     bool
+	LeakCheckMain   bool
+	MultilineCases  bool
+	TestingPkg      string
+	GroupByReceiver bool
+	CaseVarName     string
+	ArgsStructName  string
+	Examples        bool
+	HTTPHandlers    bool
+	TemplateDir     string
+	TemplateParams  map[string]interface{}
+	TemplateFuncs   template.FuncMap
+	HeaderComment   string
+	BuildTags       []string
+	// Names of the functions already in the test file, sorted.
+	TestFuncs []string
+	// Called with the syntax tree of the test file before it's formatted.
+	Transform func(*ast.File) error
 }
 
 func Process(head *models.Header, funcs []*models.Function, opt *Options) ([]byte, error) {
+	r, err := render.New(opt.TemplateDir, opt.TemplateParams, opt.TemplateFuncs, render.Names{CaseVar: opt.CaseVarName, ArgsStruct: opt.ArgsStructName})
+	if err != nil {
+		return nil, fmt.Errorf("render.New: %v", err)
+	}
+	if opt.FillContext {
+		fillContexts(head, funcs)
+	}
+	if opt.MockInterfaces {
+		markMocks(funcs)
+	}
+	if opt.BoolAsError {
+		markOks(funcs)
+	}
+	head = withImports(head, funcs, opt)
+	aliasImports(head, funcs, nil)
+	if len(opt.BuildTags) > 0 {
+		if err := addBuildTags(head, opt.BuildTags); err != nil {
+			return nil, err
+		}
+	}
+	if c := strings.TrimRight(opt.HeaderComment, "\n"); c != "" && !head.Existing && !strings.Contains(strings.Join(head.Comments, "\n"), c) {
+		// Only new test files get it, unless the comments above the
+		// package clause already have it. Merge leaves the existing ones
+		// as is too.
+		head.Banner = c
+	}
+	b := &bytes.Buffer{}
+	if err := writeTests(b, r, head, funcs, opt); err != nil {
+		return nil, err
+	}
+	out, err := formatTests(b.Bytes(), opt)
+	if err != nil {
+		return nil, err
+	}
+	return fixImports(out, opt)
+}
+
+// formatTests returns the formatted test file src, which opt.Transform
+// modifies first if it's set.
+func formatTests(src []byte, opt *Options) ([]byte, error) {
+	if opt.Transform == nil {
+		out, err := format.Source(src)
+		if err != nil {
+			return nil, fmt.Errorf("format.Source: %v", err)
+		}
+		return out, nil
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parser.ParseFile: %v", err)
+	}
+	if err := opt.Transform(f); err != nil {
+		return nil, fmt.Errorf("Transform: %v", err)
+	}
+	ast.SortImports(fset, f)
+	b := &bytes.Buffer{}
+	if err := format.Node(b, fset, f); err != nil {
+		return nil, fmt.Errorf("format.Node: %v", err)
+	}
+	return b.Bytes(), nil
+}
+
+// Merge appends the tests for funcs to the existing test file src. The
+// existing code is left as is, apart from merging the imports the new tests
+// need into its import declarations.
+func Merge(src []byte, head *models.Header, funcs []*models.Function, opt *Options) ([]byte, error) {
+	r, err := render.New(opt.TemplateDir, opt.TemplateParams, opt.TemplateFuncs, render.Names{CaseVar: opt.CaseVarName, ArgsStruct: opt.ArgsStructName})
+	if err != nil {
+		return nil, fmt.Errorf("render.New: %v", err)
+	}
+	if opt.FillContext {
+		fillContexts(head, funcs)
+	}
+	if opt.MockInterfaces {
+		markMocks(funcs)
+	}
+	if opt.BoolAsError {
+		markOks(funcs)
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ImportsOnly)
+	if err != nil {
+		return nil, fmt.Errorf("parser.ParseFile: %v", err)
+	}
+	var existing []*models.Import
+	for _, imp := range f.Imports {
+		e := &models.Import{Path: imp.Path.Value}
+		if imp.Name != nil {
+			e.Name = imp.Name.Name
+		}
+		existing = append(existing, e)
+	}
+	head = withImports(head, funcs, opt)
+	aliasImports(head, funcs, existing)
+	b := bytes.NewBuffer(append([]byte{}, src...))
+	if err := writeFunctions(b, r, funcs, opt); err != nil {
+		return nil, err
+	}
+	if err := writeMocks(b, r, src, funcs); err != nil {
+		return nil, err
+	}
+	fset = token.NewFileSet()
+	f, err = parser.ParseFile(fset, "", b.Bytes(), parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parser.ParseFile: %v", err)
+	}
+	for _, imp := range head.Imports {
+		if imp.Name == "_" {
+			continue
+		}
+		astutil.AddNamedImport(fset, f, imp.Name, strings.Trim(imp.Path, "`\""))
+	}
+	if opt.Transform != nil {
+		if err := opt.Transform(f); err != nil {
+			return nil, fmt.Errorf("Transform: %v", err)
+		}
+	}
+	b.Reset()
+	if err := format.Node(b, fset, f); err != nil {
+		return nil, fmt.Errorf("format.Node: %v", err)
+	}
+	return fixImports(b.Bytes(), opt)
+}
+
+// DeclareUpdateFlag appends the declaration of the -update flag of the tests
+// comparing results against golden files to the test file src, importing
+// the flag package.
+func DeclareUpdateFlag(src []byte, opt *Options) ([]byte, error) {
+	return appendDecl(src, opt, "flag", "Renderer.UpdateFlag", (*render.Renderer).UpdateFlag)
+}
+
+// DeclareTestMain appends a TestMain function, which runs the tests between
+// their setup and teardown, to the test file src, importing the os package.
+// With opt.LeakCheck and opt.LeakCheckMain, the function runs the tests
+// with goleak.VerifyTestMain instead, importing goleak.
+func DeclareTestMain(src []byte, opt *Options) ([]byte, error) {
+	leakCheck := opt.LeakCheck && opt.LeakCheckMain
+	path := "os"
+	if leakCheck {
+		path = "go.uber.org/goleak"
+	}
+	return appendDecl(src, opt, path, "Renderer.TestMain", func(r *render.Renderer, w io.Writer) error {
+		return r.TestMain(w, leakCheck)
+	})
+}
+
+// appendDecl appends the declaration that the method decl, named name,
+// renders to the test file src, adding the import of the package at path
+// that it uses.
+func appendDecl(src []byte, opt *Options, path, name string, decl func(*render.Renderer, io.Writer) error) ([]byte, error) {
+	r, err := render.New(opt.TemplateDir, opt.TemplateParams, opt.TemplateFuncs, render.Names{CaseVar: opt.CaseVarName, ArgsStruct: opt.ArgsStructName})
+	if err != nil {
+		return nil, fmt.Errorf("render.New: %v", err)
+	}
+	b := bytes.NewBuffer(append([]byte{}, src...))
+	if err := decl(r, b); err != nil {
+		return nil, fmt.Errorf("%v: %v", name, err)
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", b.Bytes(), parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parser.ParseFile: %v", err)
+	}
+	astutil.AddImport(fset, f, path)
+	b.Reset()
+	if err := format.Node(b, fset, f); err != nil {
+		return nil, fmt.Errorf("format.Node: %v", err)
+	}
+	return b.Bytes(), nil
+}
+
+// fixImports adds the missing imports of the formatted src and removes the
+// unused ones, as goimports does, if the options ask for it.
+func fixImports(src []byte, opt *Options) ([]byte, error) {
+	if !opt.FixImports {
+		return src, nil
+	}
 	tf, err := ioutil.TempFile("", "gotests_")
 	if err != nil {
 		return nil, fmt.Errorf("ioutil.TempFile: %v", err)
 	}
 	defer tf.Close()
 	defer os.Remove(tf.Name())
-	b := &bytes.Buffer{}
-	if err := writeTests(b, head, funcs, opt); err != nil {
-		return nil, err
-	}
-	out, err := imports.Process(tf.Name(), b.Bytes(), nil)
+	out, err := imports.Process(tf.Name(), src, nil)
 	if err != nil {
 		return nil, fmt.Errorf("imports.Process: %v", err)
 	}
 	return out, nil
 }
 
+// hasComparisons reports whether any of the tests compare results, other
+// than with their Equal method.
+func hasComparisons(funcs []*models.Function, opt *Options) bool {
+	for _, fun := range funcs {
+		for _, r := range fun.TestResults() {
+			if !(opt.UseEqualMethod && r.Type.HasEqual) && !(opt.FieldDiff && comparableFields(r.Type.Fields)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// comparableFields reports whether fs are the fields of a struct that can
+// all be compared with ==.
+func comparableFields(fs []*models.StructField) bool {
+	for _, f := range fs {
+		if !f.Comparable {
+			return false
+		}
+	}
+	return len(fs) > 0
+}
+
+// fillContexts marks the context.Context parameters of funcs, so that the
+// tests call them with context.Background() instead of a test case's args.
+// The context package is resolved by path from the imports of head.
+func fillContexts(head *models.Header, funcs []*models.Function) {
+	var names []string
+	for _, imp := range head.Imports {
+		if imp.Path != `"context"` {
+			continue
+		}
+		switch imp.Name {
+		case "":
+			names = append(names, "context")
+		case "_", ".":
+		default:
+			names = append(names, imp.Name)
+		}
+	}
+	for _, fun := range funcs {
+		for _, p := range fun.Parameters {
+			for _, name := range names {
+				if p.Type.Value == name+".Context" && !p.Type.IsStar && !p.Type.IsVariadic {
+					p.Type.IsContext = true
+				}
+			}
+		}
+	}
+}
+
+// markMocks marks the interface parameters of funcs to be mocked.
+func markMocks(funcs []*models.Function) {
+	for _, fun := range funcs {
+		for _, p := range fun.Parameters {
+			if p.IsMockable() {
+				p.Type.IsMock = true
+			}
+		}
+	}
+}
+
+// markOks marks the trailing bool results of funcs without an error to be
+// checked against a wantOk field, unnaming the other results named ok so
+// that their fields don't clash with it.
+func markOks(funcs []*models.Function) {
+	for _, fun := range funcs {
+		ok := fun.OkResult()
+		if ok == nil {
+			continue
+		}
+		ok.IsOk = true
+		for _, r := range fun.Results {
+			if r != ok && strings.Title(r.Name) == "Ok" {
+				r.Name = ""
+			}
+		}
+	}
+}
+
+// writeMocks writes a mock for each type of the mocked parameters of funcs,
+// unless the existing code src already declares it.
+func writeMocks(b io.Writer, r *render.Renderer, src []byte, funcs []*models.Function) error {
+	seen := make(map[string]bool)
+	for _, fun := range funcs {
+		for _, p := range fun.Parameters {
+			if !p.IsMock() || seen[p.MockName()] {
+				continue
+			}
+			seen[p.MockName()] = true
+			if bytes.Contains(src, []byte("type "+p.MockName()+" struct")) {
+				continue
+			}
+			if err := r.Mock(b, p); err != nil {
+				return fmt.Errorf("Renderer.Mock: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// hasContexts reports whether any of funcs has a context.Context parameter
+// filled in by the tests.
+func hasContexts(funcs []*models.Function) bool {
+	for _, fun := range funcs {
+		for _, p := range fun.Parameters {
+			if p.IsContext() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// returnsErrors reports whether any of funcs returns an error.
+func returnsErrors(funcs []*models.Function) bool {
+	for _, fun := range funcs {
+		if fun.ReturnsError {
+			return true
+		}
+	}
+	return false
+}
+
+// orderedSlices reports whether any of funcs returns a slice of ordered
+// elements, as models.Field.OrderedElem finds them.
+func orderedSlices(funcs []*models.Function) bool {
+	for _, fun := range funcs {
+		if fun.Unexposable {
+			continue
+		}
+		for _, r := range fun.TestResults() {
+			if r.OrderedElem() != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasTestFunctions reports whether any of funcs is rendered by
+// Renderer.TestFunction, rather than as an HTTP handler or an unexposable
+// function.
+func hasTestFunctions(funcs []*models.Function, opt *Options) bool {
+	for _, fun := range funcs {
+		if !fun.Unexposable && !(opt.HTTPHandlers && fun.IsHTTPHandler()) {
+			return true
+		}
+	}
+	return false
+}
+
+// caseTimeout returns the timeout of the subtests, which is zero without
+// Subtests, and with Panics since the panics of the calls in goroutines
+// can't be recovered.
+func caseTimeout(opt *Options) time.Duration {
+	if !opt.Subtests || opt.Panics {
+		return 0
+	}
+	return opt.CaseTimeout
+}
+
+// numberCases reports whether the subtests are named after the indexes of
+// their test cases, which only slices of subtests are.
+func numberCases(opt *Options) bool {
+	return opt.NumberCases && opt.Subtests && opt.TableStyle != "map"
+}
+
+// autoName reports whether the subtests are named after the arguments of
+// their test cases, which they aren't without Subtests, or with the "map"
+// TableStyle, whose keys name them.
+func autoName(opt *Options) bool {
+	return opt.AutoName && opt.Subtests && opt.TableStyle != "map"
+}
+
+// hasTestParameters reports whether any of funcs has a test with arguments.
+func hasTestParameters(funcs []*models.Function, opt *Options) bool {
+	for _, fun := range funcs {
+		if !fun.Unexposable && !(opt.HTTPHandlers && fun.IsHTTPHandler()) && len(fun.TestParameters()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// hasTempPaths reports whether any of funcs has a test setting up a
+// parameter that looks like a path of the kind, as returned by TempPath.
+func hasTempPaths(funcs []*models.Function, opt *Options, kind string) bool {
+	for _, fun := range funcs {
+		if fun.Unexposable || opt.HTTPHandlers && fun.IsHTTPHandler() {
+			continue
+		}
+		for _, p := range fun.TestParameters() {
+			if p.TempPath() == kind {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// captureStdout reports whether the tests capture what the functions print
+// to os.Stdout, which parallel tests can't.
+func captureStdout(opt *Options) bool {
+	return opt.CaptureStdout && !(opt.Parallel && opt.Subtests)
+}
+
+// printsStdout reports whether any of funcs has a test capturing what it
+// prints to os.Stdout.
+func printsStdout(funcs []*models.Function, opt *Options) bool {
+	for _, fun := range funcs {
+		if fun.PrintsStdout && !fun.Unexposable && !(opt.HTTPHandlers && fun.IsHTTPHandler()) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasBoundaryCases reports whether any of funcs has test cases seeded with
+// the boundary values of its numeric parameters.
+func hasBoundaryCases(funcs []*models.Function) bool {
+	for _, fun := range funcs {
+		if fun.Unexposable || fun.IsHTTPHandler() {
+			continue
+		}
+		for _, p := range fun.TestParameters() {
+			if p.NumberType() != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// concurrencyCase reports whether the test cases have a concurrent subtest,
+// which they don't with Panics since the panics of the calls in goroutines
+// can't be recovered.
+func concurrencyCase(opt *Options) bool {
+	return opt.ConcurrencyCase && !opt.Panics
+}
+
+// returnsChans reports whether any of funcs has a test receiving from a
+// channel it returns.
+func returnsChans(funcs []*models.Function) bool {
+	for _, fun := range funcs {
+		if fun.ReturnsChan() && !fun.Unexposable {
+			return true
+		}
+	}
+	return false
+}
+
+// hasHandlers reports whether any of funcs is an HTTP handler.
+func hasHandlers(funcs []*models.Function) bool {
+	for _, fun := range funcs {
+		if fun.IsHTTPHandler() {
+			return true
+		}
+	}
+	return false
+}
+
+// withImports returns a copy of the header that also has the imports the
+// options require.
+func withImports(head *models.Header, funcs []*models.Function, opt *Options) *models.Header {
+	h := *head
+	h.Imports = nil
+	for _, imp := range head.Imports {
+		cp := *imp
+		h.Imports = append(h.Imports, &cp)
+	}
+	if opt.CmpDiff && (hasComparisons(funcs, opt) || captureStdout(opt) && printsStdout(funcs, opt)) {
+		h.Imports = append(h.Imports, &models.Import{Path: `"github.com/google/go-cmp/cmp"`, Fixed: true})
+	}
+	if opt.SortSlices && orderedSlices(funcs) {
+		if opt.CmpDiff {
+			addImport(&h, `"github.com/google/go-cmp/cmp/cmpopts"`)
+		} else {
+			addImport(&h, `"sort"`)
+		}
+	}
+	if hasContexts(funcs) || caseTimeout(opt) > 0 && hasTestFunctions(funcs, opt) {
+		addImport(&h, `"context"`)
+	}
+	if caseTimeout(opt) > 0 && hasTestFunctions(funcs, opt) || opt.AsyncPattern && returnsChans(funcs) {
+		addImport(&h, `"time"`)
+	}
+	if concurrencyCase(opt) && hasTestFunctions(funcs, opt) {
+		addImport(&h, `"sync"`)
+	}
+	if numberCases(opt) && hasTestFunctions(funcs, opt) {
+		addImport(&h, `"fmt"`)
+	}
+	if opt.TempDirForPaths && hasTempPaths(funcs, opt, "file") {
+		addImport(&h, `"path/filepath"`)
+	}
+	if opt.TempDirForPaths && hasTempPaths(funcs, opt, "os.File") {
+		addImport(&h, `"os"`)
+	}
+	if autoName(opt) && hasTestParameters(funcs, opt) {
+		addImport(&h, `"fmt"`)
+		addImport(&h, `"strings"`)
+	}
+	if captureStdout(opt) && printsStdout(funcs, opt) {
+		addImport(&h, `"bytes"`)
+		addImport(&h, `"io"`)
+		addImport(&h, `"os"`)
+	}
+	if opt.BoundaryCases && hasBoundaryCases(funcs) {
+		addImport(&h, `"math"`)
+	}
+	if opt.ErrorComparison == "is" && returnsErrors(funcs) {
+		addImport(&h, `"errors"`)
+	}
+	if opt.LeakCheck && !opt.LeakCheckMain && hasTestFunctions(funcs, opt) {
+		addImport(&h, `"go.uber.org/goleak"`)
+	}
+	if customTesting(opt) && len(funcs) > 0 {
+		addImport(&h, strconv.Quote(opt.TestingPkg))
+	}
+	if opt.HTTPHandlers && hasHandlers(funcs) {
+		addImport(&h, `"net/http"`)
+		addImport(&h, `"net/http/httptest"`)
+	}
+	if opt.FixImports {
+		return &h
+	}
+	// Without goimports to add them, the imports the tests use must be
+	// in the header.
+	if !customTesting(opt) || opt.Benchmarks || opt.Fuzz {
+		addImport(&h, `"testing"`)
+	}
+	for _, fun := range funcs {
+		if fun.Unexposable {
+			if len(fun.TestParameters()) < len(fun.Parameters) {
+				addImport(&h, `"bytes"`)
+			}
+			continue
+		}
+		golden := opt.Golden && fun.ReturnsText()
+		stdout := captureStdout(opt) && fun.PrintsStdout && !(opt.HTTPHandlers && fun.IsHTTPHandler())
+		if fun.ReturnsError || (len(fun.TestResults()) > 0 || stdout) && !opt.CmpDiff || opt.Panics || golden || opt.HTTPHandlers && fun.IsHTTPHandler() {
+			if opt.Assertion != "testify" || opt.Panics || opt.HTTPHandlers && fun.IsHTTPHandler() {
+				addImport(&h, `"fmt"`)
+			}
+			if opt.AllowError {
+				addImport(&h, `"github.com/stretchr/testify/assert"`)
+			} else {
+				addImport(&h, `"github.com/stretchr/testify/require"`)
+			}
+		}
+		if golden {
+			addImport(&h, `"os"`)
+			addImport(&h, `"path/filepath"`)
+		}
+		if opt.Examples && len(fun.Results) > 0 || opt.Benchmarks && len(opt.BenchSizes) > 0 && fun.SizeParameter() != nil {
+			addImport(&h, `"fmt"`)
+		}
+		if len(fun.TestParameters()) < len(fun.Parameters) {
+			addImport(&h, `"bytes"`)
+		}
+	}
+	return &h
+}
+
+// addBuildTags adds tags to the build constraint of h, unless it already
+// requires them, and renders it as a //go:build line followed by the
+// matching // +build lines, which replace those among its comments.
+func addBuildTags(h *models.Header, tags []string) error {
+	var x constraint.Expr
+	if h.BuildConstraint != "" {
+		var err error
+		if x, err = constraint.Parse(h.BuildConstraint); err != nil {
+			return fmt.Errorf("constraint.Parse: %v", err)
+		}
+	}
+	for _, tag := range tags {
+		if requires(x, tag) {
+			continue
+		}
+		t, err := constraint.Parse("//go:build " + tag)
+		if _, ok := t.(*constraint.TagExpr); err != nil || !ok {
+			return fmt.Errorf("invalid build tag %q", tag)
+		}
+		if x == nil {
+			x = t
+		} else {
+			x = &constraint.AndExpr{X: x, Y: t}
+		}
+	}
+	lines, err := constraint.PlusBuildLines(x)
+	if err != nil {
+		return fmt.Errorf("constraint.PlusBuildLines: %v", err)
+	}
+	h.BuildConstraint = strings.Join(append([]string{"//go:build " + x.String()}, lines...), "\n")
+	var comments []string
+	for _, c := range h.Comments {
+		if !constraint.IsPlusBuild(c) {
+			comments = append(comments, c)
+		}
+	}
+	h.Comments = comments
+	return nil
+}
+
+// requires reports whether x is tag, or a conjunction requiring it.
+func requires(x constraint.Expr, tag string) bool {
+	switch v := x.(type) {
+	case *constraint.TagExpr:
+		return v.Tag == tag
+	case *constraint.AndExpr:
+		return requires(v.X, tag) || requires(v.Y, tag)
+	}
+	return false
+}
+
+// testingT matches the *testing.T type of the parameters of the rendered
+// tests.
+var testingT = regexp.MustCompile(`\*testing\.T\b`)
+
+// retarget replaces the *testing.T type in the rendered test src with the T
+// type of opt.TestingPkg, if set.
+func retarget(src []byte, opt *Options) []byte {
+	if !customTesting(opt) {
+		return src
+	}
+	return testingT.ReplaceAll(src, []byte("*"+path.Base(opt.TestingPkg)+".T"))
+}
+
+// customTesting reports whether the tests use the T type of a package other
+// than testing.
+func customTesting(opt *Options) bool {
+	return opt.TestingPkg != "" && opt.TestingPkg != "testing"
+}
+
+// expandLiterals breaks the keyed composite literals with several elements
+// that are on a single line of the rendered test function src, such as
+// those of the arguments and results of its test cases, onto one line per
+// element, as hand-written tables of test cases have them.
+func expandLiterals(src []byte) ([]byte, error) {
+	const pkg = "package p\n"
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", append([]byte(pkg), src...), parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parser.ParseFile: %v", err)
+	}
+	offset := func(p token.Pos) int { return fset.Position(p).Offset - len(pkg) }
+	line := func(p token.Pos) int { return fset.Position(p).Line }
+	// The newlines to insert by their offsets in src.
+	breaks := make(map[int]string)
+	ast.Inspect(f, func(n ast.Node) bool {
+		cl, ok := n.(*ast.CompositeLit)
+		if !ok || len(cl.Elts) < 2 || line(cl.Lbrace) != line(cl.Rbrace) {
+			return true
+		}
+		if _, keyed := cl.Elts[0].(*ast.KeyValueExpr); !keyed {
+			return true
+		}
+		for _, e := range cl.Elts {
+			breaks[offset(e.Pos())] = "\n"
+		}
+		last := cl.Elts[len(cl.Elts)-1]
+		if bytes.Contains(src[offset(last.End()):offset(cl.Rbrace)], []byte(",")) {
+			breaks[offset(cl.Rbrace)] = "\n"
+		} else {
+			breaks[offset(cl.Rbrace)] = ",\n"
+		}
+		return true
+	})
+	if len(breaks) == 0 {
+		return src, nil
+	}
+	offs := make([]int, 0, len(breaks))
+	for off := range breaks {
+		offs = append(offs, off)
+	}
+	sort.Ints(offs)
+	var b bytes.Buffer
+	prev := 0
+	for _, off := range offs {
+		b.Write(src[prev:off])
+		b.WriteString(breaks[off])
+		prev = off
+	}
+	b.Write(src[prev:])
+	return b.Bytes(), nil
+}
+
+// addImport adds the unnamed import of path to h, unless h already has it,
+// for the templates to refer to by name.
+func addImport(h *models.Header, path string) {
+	for _, imp := range h.Imports {
+		if imp.Path == path && imp.Name == "" {
+			imp.Fixed = true
+			return
+		}
+	}
+	h.Imports = append(h.Imports, &models.Import{Path: path, Fixed: true})
+}
+
+// aliasImports aliases the imports of h that share their name with the
+// import of another package, which would collide, and requalifies the types
+// of funcs accordingly. The Fixed imports, and the imports of the test file
+// that the tests are appended to, in existing, keep their names.
+func aliasImports(h *models.Header, funcs []*models.Function, existing []*models.Import) {
+	all := &models.Header{Imports: append(append([]*models.Import{}, existing...), h.Imports...)}
+	// The path of the package that keeps each name, which is that of an
+	// existing or fixed import if any.
+	owners := make(map[string]string)
+	own := func(imp *models.Import) {
+		if _, ok := owners[imp.Ident()]; !ok {
+			owners[imp.Ident()] = imp.Path
+		}
+	}
+	for _, imp := range existing {
+		own(imp)
+	}
+	for _, fixed := range []bool{true, false} {
+		for _, imp := range h.Imports {
+			if imp.Fixed == fixed {
+				own(imp)
+			}
+		}
+	}
+	names := make(map[string]string)
+	for _, imp := range h.Imports {
+		name := imp.Ident()
+		if imp.Fixed || owners[name] == imp.Path || name == "_" || !token.IsIdentifier(name) {
+			continue
+		}
+		if alias, ok := names[name]; ok {
+			// Another import of the same package.
+			imp.Name = alias
+			continue
+		}
+		names[name] = all.Alias(imp)
+	}
+	for _, fun := range funcs {
+		fun.Requalify(names)
+	}
+}
+
 func IsFileExist(path string) bool {
 	_, err := os.Stat(path)
 	return !os.IsNotExist(err)
 }
 
-func writeTests(w io.Writer, head *models.Header, funcs []*models.Function, opt *Options) error {
+func writeTests(w io.Writer, r *render.Renderer, head *models.Header, funcs []*models.Function, opt *Options) error {
 	b := bufio.NewWriter(w)
-	if err := render.Header(b, head); err != nil {
-		return fmt.Errorf("render.Header: %v", err)
+	if err := r.Header(b, head); err != nil {
+		return fmt.Errorf("Renderer.Header: %v", err)
 	}
-	for _, fun := range funcs {
-		if err := render.TestFunction(b, fun, opt.PrintInputs, opt.Subtests, opt.AllowError); err != nil {
-			return fmt.Errorf("render.TestFunction: %v", err)
-		}
+	if err := writeFunctions(b, r, funcs, opt); err != nil {
+		return err
+	}
+	if err := writeMocks(b, r, head.Code, funcs); err != nil {
+		return err
 	}
 	return b.Flush()
 }
+
+// testOptions returns the options of the tests of opt, with those that
+// don't apply to them turned off.
+func testOptions(opt *Options) *render.TestOptions {
+	o := opt.TestOptions
+	o.CaseTimeout = caseTimeout(opt)
+	o.NumberCases = numberCases(opt)
+	o.CaptureStdout = captureStdout(opt)
+	o.LeakCheck = opt.LeakCheck && !opt.LeakCheckMain
+	o.ConcurrencyCase = concurrencyCase(opt)
+	o.AutoName = autoName(opt)
+	return &o
+}
+
+func writeFunctions(b io.Writer, r *render.Renderer, funcs []*models.Function, opt *Options) error {
+	if opt.GroupByReceiver {
+		funcs = groupByReceiver(funcs)
+	}
+	testOpt := testOptions(opt)
+	for i, fun := range funcs {
+		if opt.GroupByReceiver && (i == 0 || receiverName(funcs[i-1]) != receiverName(fun)) {
+			if _, err := fmt.Fprintf(b, "\n%v\n", sectionComment(fun)); err != nil {
+				return err
+			}
+		}
+		t := &bytes.Buffer{}
+		if fun.Unexposable {
+			if err := r.UnexposableFunction(t, fun, opt.CopyDoc); err != nil {
+				return fmt.Errorf("Renderer.UnexposableFunction: %v", err)
+			}
+			if _, err := b.Write(retarget(t.Bytes(), opt)); err != nil {
+				return err
+			}
+			continue
+		}
+		if opt.HTTPHandlers && fun.IsHTTPHandler() {
+			if err := r.HandlerFunction(t, fun, opt.Subtests, opt.AllowError, opt.CopyDoc); err != nil {
+				return fmt.Errorf("Renderer.HandlerFunction: %v", err)
+			}
+			if _, err := b.Write(retarget(t.Bytes(), opt)); err != nil {
+				return err
+			}
+		} else {
+			if err := r.TestFunction(t, fun, testOpt); err != nil {
+				return fmt.Errorf("Renderer.TestFunction: %v", err)
+			}
+			src := t.Bytes()
+			if opt.MultilineCases {
+				var err error
+				if src, err = expandLiterals(src); err != nil {
+					return err
+				}
+			}
+			if _, err := b.Write(retarget(src, opt)); err != nil {
+				return err
+			}
+		}
+		if opt.Benchmarks && !contains(opt.TestFuncs, fun.BenchmarkName()) {
+			if err := r.BenchmarkFunction(b, fun, opt.BenchSizes); err != nil {
+				return fmt.Errorf("Renderer.BenchmarkFunction: %v", err)
+			}
+		}
+		if opt.Examples && len(fun.Results) > 0 && !contains(opt.TestFuncs, fun.ExampleName()) {
+			if err := r.ExampleFunction(b, fun); err != nil {
+				return fmt.Errorf("Renderer.ExampleFunction: %v", err)
+			}
+		}
+		if opt.Fuzz && fun.IsFuzzable() && !contains(opt.TestFuncs, fun.FuzzName()) {
+			if err := r.FuzzFunction(b, fun); err != nil {
+				return fmt.Errorf("Renderer.FuzzFunction: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// groupByReceiver returns funcs, in the order of models.SortFunctions, with
+// the functions moved before the methods.
+func groupByReceiver(funcs []*models.Function) []*models.Function {
+	fs := append([]*models.Function{}, funcs...)
+	sort.SliceStable(fs, func(i, j int) bool {
+		return fs[i].Receiver == nil && fs[j].Receiver != nil
+	})
+	return fs
+}
+
+// receiverName returns the name of the receiver type of f, or "" if it's a
+// function.
+func receiverName(f *models.Function) string {
+	if f.Receiver == nil {
+		return ""
+	}
+	return f.Receiver.Type.TypeName()
+}
+
+// sectionComment returns the comment starting the section of the tests of
+// the functions, or of the methods of the receiver type, of f.
+func sectionComment(f *models.Function) string {
+	if n := receiverName(f); n != "" {
+		return "// Tests for type " + n
+	}
+	return "// Tests for functions"
+}
+
+func contains(ss []string, s string) bool {
+	i := sort.SearchStrings(ss, s)
+	return i < len(ss) && ss[i] == s
+}
@@ -1,12 +1,25 @@
 // Code generated by go-bindata.
 // sources:
+// templates/benchmark.tmpl
 // templates/call.tmpl
+// templates/example.tmpl
 // templates/function.tmpl
+// templates/fuzz.tmpl
+// templates/handler.tmpl
 // templates/header.tmpl
 // templates/inline.tmpl
 // templates/inputs.tmpl
 // templates/message.tmpl
+// templates/mock.tmpl
+// templates/panics.tmpl
 // templates/results.tmpl
+// templates/should.tmpl
+// templates/stdout.tmpl
+// templates/testifymsg.tmpl
+// templates/testmain.tmpl
+// templates/typeargs.tmpl
+// templates/unexposable.tmpl
+// templates/update.tmpl
 // DO NOT EDIT!
 
 package bindata
@@ -23,20 +36,7 @@ import (
 	"time"
 )
 
-func bindataRead(data []byte, name string, file bool) ([]byte, error) {
-	if file {
-		f, err := os.Open(fmt.Sprintf("%s%s", "internal/render/", name))
-		if err != nil {
-			return nil, fmt.Errorf("Open %q: %v", name, err)
-		}
-
-		buf, err := ioutil.ReadAll(f)
-		if err != nil {
-			return nil, fmt.Errorf("Read %q: %v", name, err)
-		}
-		return buf, nil
-	}
-
+func bindataRead(data []byte, name string) ([]byte, error) {
 	gz, err := gzip.NewReader(bytes.NewBuffer(data))
 	if err != nil {
 		return nil, fmt.Errorf("Read %q: %v", name, err)
@@ -87,13 +87,32 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesCallTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\x8e\x41\x6a\xc3\x40\x0c\x45\xaf\x22\x8c\x17\x2d\x18\x1d\xa0\xd0\x03\x78\x53\x4a\x5b\xda\xb5\x18\xcb\xae\xc0\x9e\x16\x8d\x92\x10\x84\xee\x1e\xc6\x38\x99\xd5\x87\x3f\x6f\xde\x97\xfb\xc4\xb3\x64\x86\x2e\xd1\xba\x76\x11\xee\x17\xb1\x5f\xc0\x0f\x4e\x2c\x67\xd6\xda\xc8\x0c\xf9\xcf\x00\xc7\xf2\x69\x7a\x4a\x16\x61\x86\xee\x9c\xa7\xfa\x7a\x27\x01\x23\x5a\x8b\x6f\xb4\x71\xc4\x93\xbb\x52\x5e\x18\x7a\x19\xa0\xe7\x15\x5e\x5e\x01\xdf\x49\x69\x63\x63\x2d\x87\xbd\x97\x88\x01\x1e\x7f\xdb\xde\x8f\x8a\xd5\x1b\xcc\x90\x74\x29\x4d\xbf\x2b\xea\xe2\x4e\xe3\xd7\xf5\x9f\x71\x2c\xdf\xa4\x42\x93\xa4\x08\xc4\xc6\xee\xf1\x7c\xe4\x2d\x00\x00\xff\xff\x65\x08\xbc\x88\xf1\x00\x00\x00")
+var _templatesBenchmarkTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x6e\xdb\x38\x10\x3d\x4b\x5f\x31\x10\xb4\x81\xbd\x71\x98\x3d\x3b\xeb\x43\x82\xdd\xa2\x39\x34\x29\x62\xa3\x3d\x14\x45\x20\x5b\x43\x87\x88\x4c\xbb\x24\x95\x22\x19\xf0\xdf\x0b\x52\x94\x42\x4b\x4a\xd3\x93\xc8\x11\x87\xf3\xe6\xbd\x79\x24\x2a\x91\x0b\x89\x90\xad\x51\x6e\x1e\x76\x85\x7a\xcc\xac\x4d\x89\xce\x20\xe7\x30\x5f\x00\x6b\x77\x5a\xbc\xa0\x0b\x14\xb2\x04\x76\xe5\x0e\x2f\xc5\x0b\x6a\x60\xee\xf3\xb9\x50\xc5\x0e\x0d\x2a\x6b\xd3\x94\xd7\x72\x03\x44\xcd\x21\x77\xe3\x4d\xb1\x43\x6b\x27\x6b\xf8\xdb\xa0\x36\x42\x6e\xd9\xd5\x14\x28\x4d\x5c\x95\x9f\xc2\x3c\x00\xbb\xc3\x0d\x8a\x27\x9f\x9e\xf8\xb0\xe0\xc0\xae\xf5\xd2\xa8\x7a\x63\x7c\xb0\x8b\x7e\x10\x58\x95\xba\x89\x25\xe6\xf9\x80\xc0\x7d\x04\xb4\x3f\xec\xee\x0d\xa7\x55\x21\xb7\xd8\x4b\x48\x88\xfc\xde\xf5\xe5\x30\xae\x9e\x0f\x18\x7e\xb9\x02\x28\xcb\xb0\xb3\x69\x2f\x14\xad\x7b\x4b\x87\x75\x85\xda\x74\x24\x78\x74\x1e\x1a\x11\xbb\x54\xdb\xd0\x47\x43\x43\x8c\x33\x42\x39\xbc\xc0\xd7\xf7\xa1\x63\xb0\x44\x82\x37\x2a\xb8\x3d\xbb\xd6\x9f\xf6\x9b\x47\x98\xc8\xbd\x81\xb0\x99\x5a\x0b\xe7\xe7\xb0\xba\xfd\xef\x76\x0e\x2e\xf0\x9a\xcc\x88\x02\xf2\xb8\xb9\xf1\x9e\x3e\x16\xfa\x5a\x1e\x6a\xd3\xb4\x63\x9c\xf8\x3d\xec\x23\xe2\xb5\xd9\x1e\x60\xab\x60\x4f\x84\x20\x58\xf3\xe9\x78\xae\x34\xba\xc4\xd0\x47\x2c\x7d\x42\xd4\xd6\x88\xa9\x60\x5f\x8a\xaa\x0e\xea\x45\xf0\xe3\x56\x7e\xa3\x4f\x92\x8c\x89\x33\x12\x3b\xbe\xd1\x3a\xdd\x3a\x72\x2f\xcb\x12\x3a\xe3\x80\xf0\x6c\xb1\x31\x3e\x3d\x53\xde\x43\x2e\xc2\xf7\x0a\xee\x67\xd0\x5a\xaa\x19\x81\x6f\xdf\x85\x34\x04\xaf\x33\x91\x8b\x19\xe4\xda\x1d\xc8\x79\xe4\xb8\x30\x01\xb9\xb0\x76\x06\x41\x4e\xa2\xdc\xc7\x51\x96\x70\x66\x2d\x58\x2f\xd1\x9a\xdd\xd5\x72\xc2\x77\x86\x2d\x0f\x4a\x48\xc3\x27\x59\x34\x51\x8b\xbf\x9e\xb2\x06\xc3\x74\x06\xce\xb3\x43\x83\x26\x89\x31\x8c\x28\xe7\x03\x52\x58\x3c\x9a\x0b\xf0\x88\xf0\x47\x18\xc9\xa5\x51\x42\x6e\x21\x13\xd2\x64\xd6\xba\x0a\x44\x58\x69\xb4\x76\x57\x3c\xe2\xa4\x1b\xc6\x50\x3d\xf4\xe0\xca\xad\xd9\x1d\x6a\x34\x2b\xb1\x43\x35\x99\x1e\xb1\xe8\x38\x13\x8e\x8c\x7f\x2e\x40\xc0\xbf\xb0\x66\x37\x17\x20\x4e\x4f\xff\x64\x18\xdf\x99\xa7\x79\x68\xa0\x35\xd4\xd2\x14\xca\xda\x93\x80\xab\x37\x6d\xef\xbe\x2f\xac\xf1\xf9\x1c\x8c\x61\xcd\x88\xb3\xe8\xd1\x99\x75\xe9\x5d\xd3\x49\x07\xb5\xf5\xc0\x31\x90\x37\x20\x7b\x69\xe2\x60\xda\xbb\xb8\xbf\x0e\x78\x87\x36\x68\x39\xfa\xaa\x84\x41\x35\xf2\xf6\xcc\x17\x70\xb2\x7e\x36\xa8\xd9\x55\xcd\x39\x2a\x1a\x22\x6e\x5e\x9e\x37\x72\x89\x98\xfb\xdb\x10\x43\xef\x00\x15\x1c\xf6\xca\xbd\x2a\xba\xae\x8c\x76\x0b\x53\x2b\xa9\xff\x57\x6a\xaf\xdc\x8c\x47\xe6\xc0\xca\x5d\xdf\x1e\x1d\x33\xc6\x7d\x27\xa2\xe0\xc3\xab\x04\x8f\x92\x07\x39\xb0\x68\x23\x44\x06\x77\x87\xaa\x30\x08\xd9\xa6\xa8\xaa\x0c\x72\x1e\xbd\x9b\x82\xbf\x5a\x3b\xb1\xd3\x9e\xff\x6d\x9a\x12\xa1\x2c\xad\x4d\x7f\x0d\x00\x2c\x0e\x3f\x1d\x6e\x07\x00\x00")
+
+func templatesBenchmarkTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesBenchmarkTmpl,
+		"templates/benchmark.tmpl",
+	)
+}
+
+func templatesBenchmarkTmpl() (*asset, error) {
+	bytes, err := templatesBenchmarkTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/benchmark.tmpl", size: 1902, mode: os.FileMode(420), modTime: time.Unix(1792001046, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesCallTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x90\x4d\x6a\x03\x31\x0c\x85\xaf\x22\x86\x59\x24\x10\x74\x80\x42\x17\x6d\x56\x59\xb4\xf4\x8f\x76\x2d\x3c\x9a\xa9\xc8\xc4\x0e\x1a\xa5\x6d\x30\xba\x7b\xf1\xfc\x24\x94\xae\x6c\xcb\x7a\x4f\xef\x53\xce\x0d\xb7\x12\x19\xaa\x40\x7d\x5f\xb9\xe7\xfc\x2d\xf6\x09\xf8\xc2\x81\xe5\x8b\xb5\x54\xa4\x85\x98\x0c\x56\x49\x01\x77\xc3\xab\xe9\x29\x18\xe0\xdb\xf9\xc8\xe3\x93\x14\x6a\xdc\xa6\x38\x8c\x1f\x49\xd7\xee\x66\x98\x33\xc7\xa6\xa8\x17\x27\x40\xf7\x52\xed\x07\xbe\x8c\xa9\xf1\xf9\x44\xbd\xb4\x32\x0d\x9a\x3b\x26\xdd\x2c\xc7\x47\x3a\x8c\x02\xe3\xc3\xb1\x27\x63\xa8\xec\x7c\x64\xd2\x6e\xa8\x8a\xe5\x2a\x67\xa5\xd8\x31\xd4\xb2\x81\x9a\x7b\xb8\xb9\x05\x7c\x22\xa5\x03\x1b\xeb\x30\xe7\xaf\xc5\x7d\x03\x17\x53\x69\x0b\xc9\x36\x45\xe3\x1f\x73\x0f\xd3\x05\xef\x29\xec\x3b\x4d\xa7\xd8\xac\xd6\xd7\xa4\x7f\xf1\x3f\x54\xac\xc0\xec\x86\x87\x14\xf6\x0b\x6b\x8d\x77\xda\xcd\xab\x99\x02\x5f\x41\xc6\x30\x25\xea\x68\xb5\xec\xed\x9d\x54\xa8\x91\xe0\x8e\xf8\x0f\x7a\x3c\xd6\x39\x73\x6c\xdc\x7f\x07\x00\x1f\xe6\x68\x25\xa4\x01\x00\x00")
 
 func templatesCallTmplBytes() ([]byte, error) {
 	return bindataRead(
 		_templatesCallTmpl,
 		"templates/call.tmpl",
-		false,
 	)
 }
 
@@ -103,18 +122,37 @@ func templatesCallTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/call.tmpl", size: 241, mode: os.FileMode(420), modTime: time.Unix(1479275664, 0)}
+	info := bindataFileInfo{name: "templates/call.tmpl", size: 420, mode: os.FileMode(420), modTime: time.Unix(1792006403, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesExampleTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\xb1\x6a\xf3\x40\x10\x84\x6b\xdf\x53\x2c\x42\x85\x04\xfe\xcf\xbd\xe1\x2f\x53\xa4\x49\x9c\x10\x5c\xa4\x5b\xa4\x39\x67\xe1\x74\x16\xa7\x53\x1c\xb3\xec\xbb\x07\x59\xc6\xa4\x9a\x62\xbe\x61\x3e\xd5\x1e\x41\x12\xa8\xc2\x0f\x0f\x63\x44\x65\xe6\x54\xff\x51\x1d\x68\xff\x9f\xbc\x99\x73\x61\x4e\x1d\xa9\xfa\xa7\x95\x78\xe1\x01\x66\x4d\x4b\xea\x36\x61\x28\xfe\x90\x25\x95\x98\x1a\xd5\x8b\x94\x2f\xf2\xef\xe8\x20\xdf\xc8\x66\x09\x97\x46\xd5\x7f\x5c\x47\xf8\x23\xc7\x19\x66\xad\x57\x45\x9c\x60\x76\xc7\xeb\xe0\xdf\x66\x8e\x12\x64\x59\xa8\x7a\xb3\x05\x49\xbd\xd9\x23\xfc\x7a\xa9\x5a\x30\x8c\x91\x0b\xa8\x2a\xd7\x11\x9c\x4f\x53\xb5\x28\x36\xaa\x99\xd3\x09\x54\xcb\x96\x6a\xc4\x9b\xf9\x81\x33\x0f\x28\xc8\xd3\xb2\x94\x40\xe9\x5c\x68\x75\x79\x9e\x8e\x9c\x85\x7b\xe9\xee\x55\x2d\x66\x5b\x7a\xfc\x7d\x22\x9f\xc9\xff\x11\xb8\x45\xdb\xba\xcd\x6e\x47\xaf\x73\x19\xe7\xb2\x77\xe6\x9c\x2a\x52\x6f\xe6\x7e\x07\x00\xb0\x29\x94\x91\x45\x01\x00\x00")
+
+func templatesExampleTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesExampleTmpl,
+		"templates/example.tmpl",
+	)
+}
+
+func templatesExampleTmpl() (*asset, error) {
+	bytes, err := templatesExampleTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/example.tmpl", size: 325, mode: os.FileMode(420), modTime: time.Unix(1791996705, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesFunctionTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x4f\xe3\x3a\x14\x5d\x27\xbf\xe2\x52\x01\x6a\x9f\x8a\xd9\x17\x75\xf1\x10\xbc\xa7\xb7\x78\x74\x54\xd0\xb0\x18\x8d\x46\x26\xbd\xe9\x58\xe3\x3a\x1d\xfb\x06\x54\x59\xfe\xef\x23\x3b\xce\x67\x53\x86\xcd\xb0\x20\xc9\xf5\xc7\x39\x3e\xf7\x1c\x83\xb5\x1b\xcc\x85\x42\x98\xe4\xa5\xca\x48\x14\x6a\xe2\x5c\x6a\xed\x15\x9c\xe7\xb0\x58\x02\x73\x2e\x4d\xfd\x10\x58\xcb\x9e\xd0\xd0\x03\xdf\xa1\x73\x53\x82\xbf\x08\x0d\x09\xb5\x65\x4f\x33\xb0\x69\xe2\x97\xbc\x09\xfa\x0e\x6c\x8d\x19\x8a\x57\xd4\xce\xa5\x49\x28\x8b\x1c\xd8\x7f\xe6\x91\x74\x99\x51\x28\x36\xd5\x7f\x04\xca\x8d\xa9\x6a\x09\x1d\xf6\x08\x79\xa8\x80\x09\x93\xfd\xbe\x71\xb6\xe6\x6a\x8b\x83\x05\x89\xb5\xe1\xdb\x93\x0c\xf4\x0e\x7b\x8c\x43\x7e\x09\xaa\x4d\xfc\x6a\x30\xeb\x52\xe7\x7d\xf0\xea\x59\xf9\x63\x7e\xe2\x9a\xef\x90\x50\x07\xb0\x40\x8d\xeb\x6d\x8f\x58\x87\xd6\xf1\x8a\x00\x18\x4a\x47\xec\x3a\x88\x7d\x7c\x2f\xa8\xf1\xa2\x7f\xf9\xda\x81\x51\x7c\x87\x1e\x56\xa8\x6d\x5c\x3c\x22\x73\xcd\x9d\xab\x4d\xab\xf5\x40\xae\x28\x6d\xf5\x68\x14\x91\xa6\xd5\xac\xde\xf2\x58\xd0\x13\xe2\xbd\x23\x59\x92\x04\xbd\xfc\xaf\x91\x35\x1d\xdd\xd6\x68\x4a\x49\xa6\xc6\x79\xe6\x8a\xde\x93\xac\x81\x5c\x23\x95\x5a\x99\x7b\xad\x8b\xa8\xc1\x1b\x57\x74\xaf\x35\xbc\x14\x85\x1c\xe8\xec\x85\xbc\xbe\x86\xa7\xd5\xdd\x6a\x01\x7f\x6f\x36\xe0\xb5\x86\x8c\x1b\x34\x2c\xb4\x21\x2f\x34\x58\x2b\x72\x50\x05\x79\x05\x1f\xf8\x0f\xdc\x38\x07\xdf\xe6\x40\xe4\x7b\x62\x6d\xd8\x2b\x32\xaf\x5a\x65\x53\x88\x3f\x35\xa9\xc7\xf2\xa5\x1a\x72\x8e\xd8\xba\x54\x53\x22\xe6\x1b\x38\x07\x9f\xa1\x61\x6a\x20\x52\x84\xab\x56\xe5\xb1\xe6\x9e\x48\xd1\x51\xcb\x02\xcd\xd0\x8e\xc3\x1e\xc3\x64\xae\x9d\xbb\x8c\xd4\xa3\xa0\xec\x33\x97\x25\x3a\x67\xeb\x2d\x4e\x84\x2b\xb1\x96\x55\x61\x5f\x00\x11\xab\x6c\xc3\x3a\x91\x9b\xb7\x1b\xb4\x51\x4b\x46\xf2\x77\xf4\x11\xf1\x46\x12\x53\x1f\xf3\x59\x0b\x6a\x4e\xdf\x4b\xd2\x62\x09\x97\x2f\x07\x42\xc3\x6e\xcb\x3c\x47\x6d\x3f\x02\x18\x93\x31\x0d\xcd\x5d\x29\x79\xe8\x9a\x67\x76\x5c\x5f\x29\x0c\x2a\xcd\xa0\x61\x46\xb8\xdb\x4b\x4e\x08\x13\x5d\x19\x76\x02\xe7\x79\xb0\x69\x3b\x92\x71\x29\xab\xf2\x29\x16\x23\xae\x4d\x44\x1e\xbb\x36\x24\xe6\x1c\xa0\xd6\x55\x57\xc7\x40\x6e\x1a\x53\x4e\xfd\xbc\xb3\x25\x28\x21\x67\xfe\x49\xc4\xea\x30\xc4\x36\x13\x0b\x5b\xe6\xd3\x49\x77\xaf\x1d\x1a\xc3\xb7\x18\x8f\x82\x7e\x06\x2c\xe1\xe2\x75\x0e\xf5\xf2\x8b\xd7\xc9\xbc\x07\x2f\xd4\xbe\x6c\x0e\x8f\x5a\xcf\x3b\x60\xb3\xd6\x11\xf5\xa5\xd0\x4b\x77\x18\x1b\xc4\x44\x87\x03\x5b\x5b\x5d\x44\x59\xa1\x48\xa8\x12\xe3\xc1\xc6\x1c\xf6\xae\xa5\x8e\x21\x4f\x79\x2a\xa8\xfe\x6f\x41\x6d\x70\x1a\x8f\xb1\xc7\x70\xdb\x4e\x67\x37\x9d\x29\x95\xaa\xdd\xfb\xa9\xf5\x9d\x34\x18\x31\x6e\xb9\x11\x59\xe7\xef\x50\xd3\xdc\xf3\x7c\xcc\x5f\x3e\x94\x3d\x0e\x5d\x9d\xa5\x50\x38\x6c\xf4\x87\xf9\xfc\x21\xfc\x33\x8d\xb9\xc4\x8c\xd8\x1d\xe2\xfe\xfe\x67\xc9\xe5\xb4\xd9\x61\xde\x27\x34\xeb\x32\x6a\xba\xf7\x11\x1f\xd6\x84\x23\xd9\xff\x4b\x49\x62\x2f\x7b\x64\x23\x9f\xd6\xab\xbf\x31\xea\x49\x92\xa7\xff\x3f\x18\xf8\x14\xfc\x81\xba\xd7\xb5\x4b\x5d\x9a\xd6\x3e\xfd\x15\x00\x00\xff\xff\x12\xba\x7c\xa5\x47\x09\x00\x00")
+var _templatesFunctionTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x3c\x5d\x73\xdc\x36\x92\xcf\xa3\x5f\xd1\x66\x8d\x72\x64\x32\xa2\x92\xab\xdd\xbb\xaa\x59\xeb\x41\x96\xed\xc4\x57\xb1\xe5\xb3\x74\xce\x43\xce\x95\xa2\x48\x70\xc4\x15\x87\xa0\x41\xcc\xc8\x2a\x2e\xff\xfb\x55\xe3\x8b\x20\x09\xce\x70\x24\xd5\xde\xe6\x45\x1e\x82\x40\x7f\x77\xa3\xbb\x01\xba\xae\x13\x92\x66\x05\x01\x2f\xdd\x14\x31\xcf\x68\xe1\x35\xcd\x51\x5d\x9f\xc0\x3c\x85\xe5\x19\x84\xfa\x89\x93\x8a\x67\xe9\x03\x8e\x91\xaf\x10\x9e\x57\x15\x61\x38\x1d\x3c\xf5\xc6\xac\x8b\xc4\x2b\x9c\xe8\x31\xf2\x75\x93\x31\xe2\x35\x4d\x5d\x67\x29\x84\xe7\x79\x4e\xef\xdf\x30\x46\x19\x8e\xe8\x99\x67\xe0\xc9\x5f\x62\x1e\x29\x12\x0d\x69\x1d\x95\x1a\xdf\x75\x74\x93\x93\x2b\xfe\x90\x13\xf0\xd6\x51\x69\x90\xad\x68\x9e\x90\x02\x67\x45\x45\x02\xe1\xcf\xf2\x31\xfc\x44\xf8\x86\x15\xd5\x35\xf9\xc6\xf5\xcc\x2d\x61\x37\x38\xaf\x64\x59\xc1\x53\xf0\x8e\x8f\x8f\xb7\x1e\x84\xef\x49\x55\x45\x2b\xf2\x96\xb2\x75\x64\xe6\xf2\x6c\x4d\xe8\x86\x1b\xb0\x57\x9b\x1b\xe4\xb2\x82\xf0\x22\xaa\xc8\xb5\x7c\x6b\xf8\xdd\x70\xea\x98\x79\xbe\xe1\xf4\x43\xb4\x26\xe0\x17\x94\x0b\x5e\x02\xbd\xa2\xd8\xac\x6f\x08\x1b\xae\xf1\x29\x83\xf0\x83\x78\x89\x78\x2a\x09\x3b\x70\x40\x88\xa3\x3c\x27\x09\x42\xa0\xac\x25\x57\xcc\x13\x40\x2e\x8b\xfc\x41\xc9\x40\x88\xbb\x33\x72\x59\x90\xcf\x51\xbe\x21\x01\x82\x3b\xaa\xeb\xfb\x8c\xdf\x4a\x4a\x2e\x68\xf9\xf0\x9a\xc6\x10\xbe\xa6\x31\xea\xe2\x82\xae\xd7\xa4\xe0\x10\x2a\xc5\xc0\x49\xd3\xf4\x16\x6c\x09\x8b\x56\xe4\x97\xac\x40\x9e\x5f\xb1\xa8\x88\x6f\x49\xa5\xc8\xcc\x52\x31\x6b\x9e\x1a\xc0\xf3\x54\x82\x3e\x3d\x3d\x52\x9a\x3e\x3d\x05\xc9\x2b\xa7\x10\x23\xb4\xa5\x58\xca\xa2\x62\x45\x84\xf5\x9d\x9e\x02\xc0\x09\xd4\xb5\x36\x45\x6d\x20\x2d\x3d\x68\x5a\xbf\x66\x05\x7f\xcb\x32\x52\x24\xf9\x43\xd3\x1c\xcd\x14\xfe\xf0\x63\xc4\x50\x56\xb9\x80\x54\xd0\x3c\x2b\xf8\x92\x97\x6a\x10\x4e\x4f\x01\x05\x03\xfc\x96\x40\xa5\xd5\xc0\x36\x05\x64\x05\xe8\x49\xa1\x04\x46\xf2\x8a\xd8\x40\xf4\x6b\x5c\x83\x70\xae\x6f\x09\x88\xdf\xb1\x60\x07\x81\xd0\x82\x40\x94\x72\xc2\x04\x7c\xca\x6f\x09\xd3\xc0\x7a\x3c\xa0\xf3\x21\x8b\xd7\xa4\xe2\x68\x34\x4d\xe3\x73\xf8\x5e\x78\x56\xb1\x0a\xaf\x03\xa8\x5b\x8e\x7e\x25\xd1\xdd\xc5\x2d\x89\xef\x90\xcd\x84\xa4\x84\xc1\x8a\xe6\x24\xba\x0b\x3f\x13\x96\xa5\x0f\x1f\x68\x41\x7c\x1e\xe0\x02\x89\x06\x00\x40\x2d\x36\x06\xa2\xc5\x62\x1c\x3b\x40\x15\x73\xb2\x2e\xf3\x88\x13\xf0\xaa\x5b\xba\xc9\x13\x0f\xe6\xa9\xad\x7a\x41\x83\xd0\x7e\xf8\x89\xc4\x24\xdb\x12\x86\xa3\x9a\x34\xa1\xe8\xa2\xe2\x6c\x13\x73\x2a\xdf\xb4\x2b\xba\x2f\x05\x01\x6b\xc2\x09\xab\xe4\xbc\x19\x7f\x28\x09\x54\x84\x6f\x4a\x90\x93\x90\x67\x05\xa0\xb5\x86\x99\x1c\x12\xab\x71\x40\x08\xed\xa1\x24\x4d\x63\x26\x4b\xa6\xf1\xa9\x39\xea\x0d\x69\x3d\x0a\x39\xbe\xab\xae\x04\x9e\x96\x4e\x1c\x7d\x9b\x91\x3c\xe9\xd0\x94\x8a\x91\x51\xa2\x3a\x0b\x66\x75\x2d\x9e\xa7\x91\x66\xc9\xed\x17\x92\x97\xad\x2c\x84\x18\xd0\x16\xd0\xc3\xd1\x36\x3a\xd6\xb0\x80\x54\x11\x15\xb4\x38\x14\x61\x33\xae\x40\xf9\x81\x7c\x66\xc2\xe7\x41\x3a\x09\x4e\x15\x7c\x47\xac\x69\xbe\x53\xf6\xa1\x40\x84\x22\x26\x34\x4d\x7d\x34\xdb\xc9\xe1\xac\xae\x43\x69\xa2\x4b\x48\x43\x8b\xdf\x45\xbb\xb0\xe5\x73\xd6\x67\xd7\xbc\x1a\xe8\x45\xfe\xee\xfd\x44\xaa\x2f\xa2\x92\x6f\x18\xb9\xe2\x89\x8c\xba\xb3\xd8\x1e\x70\x8a\x28\x90\x43\x01\x6a\x2d\x2b\x56\x42\x38\x1d\xc9\xb0\x05\xdc\x2f\x80\x30\x11\x85\x69\x15\x7e\xcc\x4a\x22\x5e\x64\xa9\x18\x7d\x71\x06\x45\x96\x8b\x75\x33\x1e\xbe\x8d\x78\x94\xfb\x84\x31\x9c\x81\x04\x57\x06\x35\xad\x42\x49\xc7\xd1\x6c\x66\x7e\xc3\x19\xdc\xe3\xf3\x86\x97\x72\xd6\x3a\xba\x23\x7e\x7c\x1b\x15\x8a\x20\x84\xb3\xa2\x9a\x48\x81\x65\x1b\x31\xb8\x81\x9b\x07\x4e\xaa\xf0\xd5\x26\x4d\x09\xc3\xd1\x8c\x8a\xd8\xe9\x7f\x77\xb3\x00\x81\x5d\x03\x7d\x79\x02\x37\xe1\x95\x00\x26\xe8\x6e\xc4\x5f\xa5\xed\x21\xf3\x1d\xda\x2a\x4d\xf0\xec\x3e\xbc\xc8\x69\x25\x39\xd7\x8b\x5f\x9e\x48\x14\x92\x55\xb7\x4a\xd0\x36\xbb\x1e\x2c\x5c\xa5\xae\xc3\x73\xb6\x52\x7e\x25\x8d\xc4\xf6\x1b\xcb\xa6\x86\x00\xc6\xfc\xba\xae\xd5\x36\xa2\xad\xf7\x3d\x8d\xef\xe4\x56\xa7\x1e\x82\xa6\x11\x01\xf8\xf2\xf5\xe5\x12\xc4\x5b\xb3\x38\xd4\x31\xb0\x63\x63\x7d\x9e\xc4\xae\xfe\x39\x62\x8a\xe2\xe5\x99\x74\x17\xdc\x71\x9b\x66\x1d\x95\xbf\x4b\x41\x7e\xa9\x6b\xb9\x09\xfc\xfe\x45\x81\xed\xf1\x66\x05\x58\x5c\xab\x37\xfa\x40\xe0\x2f\x30\x17\x90\x80\x8e\x86\xd6\xef\x08\xaa\xbb\xa2\xaa\xfb\xdd\x30\xa8\xaa\x78\x8a\x7f\x47\x3c\x50\x45\x43\x21\x60\x1d\x11\x7b\x2e\xaf\x02\xa0\xfc\xc7\x5e\x68\x68\xd1\x74\x2b\xcd\x75\xa3\xab\xa5\x49\xb9\xa8\x1f\x70\xea\x7d\x31\x61\x87\xd9\xcd\x66\x2e\x9b\x73\x8c\xb9\x21\x8a\xc4\x4a\xe6\x90\x4d\x33\xb4\xd0\x4f\xa4\xda\xe4\xdc\x20\xfa\x2d\x2a\x78\xcb\xa2\x4a\x6d\xce\xab\x87\x22\xfe\x18\x71\x4e\x58\x01\xe1\xc5\x6d\x54\xbc\xc9\xc9\x5a\x70\x69\x3f\xb4\xdb\x0e\x27\x4c\x0e\xa2\x19\x59\x8f\x3d\xf1\xd8\x82\xd9\x27\x17\x3b\xd5\xeb\xd8\x0e\xa6\xce\x62\xf4\x82\xae\xcb\x88\x65\x15\x26\xec\x59\xe5\xc9\x49\xf7\x51\xc1\xdf\x30\x86\x01\x8f\xb2\xbe\x45\x38\x97\xae\x65\xb6\xdc\x5d\xff\xbe\x5a\xb5\x86\xdd\x33\x0e\x8d\xe2\x86\xd2\x7c\x8a\x86\x07\xb1\x5e\x82\xb8\x94\x41\x6f\xd4\x7d\x64\xa6\x57\x64\x71\xd5\xae\x11\xcf\x06\xb1\x19\xe9\x50\x6b\xc1\x69\xb4\x17\xcf\x69\x89\x85\x4d\xd5\xe6\xe7\x71\x94\xa6\x34\x4f\xd0\xa4\x20\xfc\x1c\xb1\x2c\x4a\xb2\xb8\xfd\x15\x5e\x8a\x05\x6f\x37\x85\x42\xaf\x9d\x53\x01\xea\x39\xb9\x5e\xa6\x86\x4d\xa0\xf1\x12\x92\x46\x9b\x9c\x83\x15\x06\xbd\x25\xe8\x5d\xda\x8e\x08\x32\xae\x48\x56\x4f\x4f\xe1\xf5\x70\x61\xd8\x57\xa7\x2e\x21\xe4\x22\x0c\x46\x4b\x70\x62\x5c\x1c\x0d\xe3\xc4\x3c\x1d\xf8\xd3\x12\x9c\xc3\x82\x4c\xa4\xe9\x7c\x1b\x65\x39\x16\x6d\xa0\xa4\x80\x0b\xa4\x6b\xcd\xb3\x05\xcc\x45\xc9\xd4\x95\x9c\x94\x45\xd6\x34\x0b\xc3\x74\x3d\xa7\xc6\x0f\xc2\xfe\x1e\xb1\xb4\x36\x09\x99\x7d\x88\xbf\xe2\x8f\x2b\xeb\xeb\xea\x41\xa8\x56\xeb\x42\x96\x20\xe3\xaa\x29\xe8\xe1\x5a\xf9\x40\x0f\x57\x48\x0f\xcf\x40\x17\x8a\xb7\x96\x30\x7e\xff\x08\xca\xae\xef\x1f\x41\x5a\x1f\xd3\x13\xed\x04\x46\x14\x09\x6d\x08\x16\x76\xb2\x45\x3b\xb9\x8a\xd6\x65\x8e\x0a\x1a\x31\x92\xad\x55\xa4\x40\x03\x3b\xcc\xc0\xfa\xad\xe2\xfc\x2b\xba\x29\x92\x88\x3d\x08\x13\x18\x28\xde\x24\xbb\xd3\x24\x6b\xa6\x4f\x93\x69\x0b\xfd\xe9\xd2\xec\x48\x2d\x12\xde\x85\xd3\xdc\x12\x93\xa2\x9f\x47\x72\xa3\x57\x70\xa3\xce\x8e\x2c\x65\x39\x2a\x49\x8c\xb8\x57\x84\x24\x72\x8b\x88\x2c\xef\x9a\x63\xc8\x45\xfc\x3a\x17\xf2\xc7\x76\xa1\x00\xfc\x9d\xbb\x4c\x10\x0c\xf4\x51\x6d\xe2\x98\x54\xd5\x34\x6d\x5c\xc9\xc9\xd3\x74\xa1\x21\xbb\x34\x21\xa1\x23\x5f\x9d\x5d\x6d\x09\x69\x94\x57\x64\xbf\x97\x8a\x0d\x76\x1a\xcd\x42\x18\xd3\x28\x96\x50\x0f\xa2\x97\xb3\xcd\x28\xb9\x4e\xef\x40\xcd\xa2\x8d\x39\x3d\x43\x7a\x85\x95\xdc\x18\x66\xea\x36\x9b\x69\x4d\xbd\x69\x3c\x1d\xcb\x17\xae\xb0\xac\xf8\x74\x98\x95\xc9\xee\xcf\x93\xc4\x6a\xb2\x84\xe3\x1e\xde\xd6\x2a\x72\x0b\xbf\xcb\xca\x37\xeb\x92\x3f\xb4\x3d\x32\xb3\xcd\xfb\xbb\x76\x82\xa0\x17\x20\x7a\xd4\x49\x0b\xe5\x02\xbe\xef\x49\x1a\x33\x8c\x55\xd8\x36\xf3\x02\x57\xed\x64\xd4\x28\x3b\x8d\x5c\x35\xf1\xc2\x5f\xa2\xea\x5d\x51\x6e\x78\xd5\x49\x3d\xbb\xb9\x9d\x4e\x72\x5c\x79\x92\x00\x87\xc2\xd6\x00\xdb\x56\xe4\x63\xe0\xa5\x94\xa9\x2a\xa8\x10\x21\x06\xff\x5a\x8a\xe6\xbc\x69\xfe\x30\xe1\x44\x8f\x2c\x80\x73\x7b\x10\xc5\x2c\x48\x12\x6f\x61\x79\xa6\x5e\x2a\xeb\x1a\x54\x5e\xf5\x51\xc7\xea\x6d\xdf\x78\x5e\x69\xb5\xdc\x61\x87\x55\xb2\x22\x4c\x31\xdb\xc5\x13\x22\xdf\x4f\x79\x07\xfe\x74\x5a\x5b\x75\x8d\x50\x0d\x7f\x20\x29\xb2\x3a\x9d\x24\x45\xd3\xfc\xb3\x1a\x80\x2d\x9a\xa6\xe1\xe1\xa7\x4d\xe1\x5b\x3e\xdd\xd3\xb1\x14\x8d\xcc\x95\xab\xf0\x03\xb9\xff\x44\xca\x3c\x8a\x09\xf3\x3d\xf0\x16\xe0\xfd\x81\x7f\x4e\xe5\xaf\x20\x54\x2f\xfd\x74\xcd\xc3\x2b\xd9\x64\xf7\xbd\xe3\xad\x87\x44\x87\x8e\x82\x2c\x08\x1c\x71\xa3\xb3\x18\x7d\xfc\x8f\xe3\xc4\x5b\x40\x16\x68\xfd\x70\x1e\x2a\x2a\x45\x18\x71\x75\x7e\xe4\x66\xa8\x41\x9b\xb2\x40\xf7\x5a\x40\xc9\x46\x36\x34\x67\xae\x46\xb1\x55\x60\x9b\xb6\xf8\xaf\x94\x96\x9f\x23\x26\x37\x34\x11\x8e\x55\x71\x7f\x98\x2d\x06\x0a\xbc\x54\x24\xe7\x8e\xd6\x20\x37\xa4\xa8\x1e\x9e\xd0\x90\x40\xa9\x7a\xb5\x7b\x5a\xb5\x23\xd5\xbd\xdd\x21\xef\x1c\x69\xf4\xb8\x7b\x8a\x6f\xb9\xd8\x73\xed\x4d\x82\xa0\x37\xc5\xf6\x4a\xf4\x27\xf0\xd7\xe7\xc8\x34\x2d\x4c\xb4\xbf\x22\x5c\x74\xce\x49\xb1\xcd\x18\x2d\xc4\x51\x04\x4d\xc5\x90\xd9\x04\xc2\x7e\x3f\xb6\x0b\x8b\x87\x57\x84\x93\x62\xeb\xd7\xb5\x39\xf8\xf9\xea\x89\x76\x25\x78\x5e\xb0\xbb\x2d\x39\xda\x99\xd9\xd9\x9a\x99\xc9\x13\x1a\x14\xc0\xd8\xfb\x4e\xbf\x44\xb7\x9b\x50\x26\x7e\x56\x24\xe4\x1b\xcc\xe3\x50\x4b\xfd\xc7\xc0\xee\xda\xaa\xbe\x97\x35\x12\x34\xcd\xf7\x26\x66\xc9\x46\x7b\x1c\xfe\xf7\x26\xca\xb3\x34\x13\x3b\x71\x1d\xb6\x6d\xb0\xba\x9e\xc7\x2a\xe3\xf4\x3b\xd5\x98\x38\x67\x9b\xc7\x9d\x06\xd2\x30\x6f\xe4\x3c\x14\xad\xa4\xd0\x24\x90\xa5\x9e\x56\x6a\x9a\xda\x12\x2a\x0c\x5b\xb4\xe2\x1f\x4b\xda\xbb\xba\x4e\xc3\x76\xb8\x43\x62\xa6\x43\xee\x73\x11\x60\x54\x3f\x7c\x80\xa1\xd7\xe5\x1f\x15\xfe\xb3\xb7\xc6\x0d\x4d\x53\x5b\xe4\x1d\xaa\x3b\xd4\x8c\x11\x2e\xe2\xaa\x3d\x38\xc1\x9a\x15\xdd\x8e\xd6\xeb\x89\x12\xd7\x6f\x2c\xe3\x84\xb9\x8e\x5a\x96\x67\xf0\x9d\xdd\x9f\xae\x1b\x97\xb8\xb1\x01\x3b\xb6\xba\xae\x43\x7c\xad\x8a\x96\x29\xf4\x66\x69\xb7\xff\x62\x91\xbb\xab\x93\x2c\x7d\x50\xb4\x70\xf4\x6a\xfb\xf8\x48\x3a\xaf\x99\x9c\xa5\x52\x96\x8e\xca\x2a\xb4\x59\x38\xb3\x0e\x05\x44\x90\x9b\xb2\x06\xea\xba\xc5\xd4\xb8\x0c\x60\xbf\x04\xae\xc9\xba\x7c\x9d\xb1\xb7\x94\x7d\x8c\xf8\xed\x61\x42\x28\xed\x43\x6f\xce\xc3\xe3\x6d\x88\x07\xdf\x03\xba\xc1\x57\x44\x07\x3d\x39\x89\xe3\x77\xb2\x2e\x11\x35\x78\x49\xc6\x3c\x4b\x6e\x75\x3d\x2f\xa5\x64\x3c\xcf\x08\x46\x0f\x02\xd7\x94\xeb\xd3\x28\x9b\x7f\xab\xcf\xd8\x82\x4f\xb3\x9c\x4c\x87\x8f\xb3\xcb\x88\xdf\x86\xff\x45\xb3\xc2\xb7\xb0\x2d\xc0\xb3\x7b\x15\x53\xb1\xd3\x2a\x7c\x3b\x4a\x80\xad\x7a\x0b\xb8\x7d\x8a\x74\xc1\x48\xc4\x09\xc2\xeb\x11\xa3\x29\x70\x1d\x2f\xcd\x86\x47\x4c\x16\xb1\x33\x1e\x5e\xe4\x24\x2a\x36\xa5\x6f\x65\x30\x06\xbb\x3e\xbf\x81\x26\xe8\x0b\xc7\x9a\xf5\x24\xe3\xb3\xfb\xaa\x33\x3b\xf1\x28\xc5\x0b\x99\x78\x8c\xae\x76\x34\x74\x67\x94\x65\xab\x2b\xe7\x09\xda\x4c\x1d\x68\x1b\x56\xed\x63\x2b\x6b\x59\xa3\x0c\x8a\x91\x28\x69\x21\x75\xce\x05\xc5\x11\xf8\x48\x65\xcc\xcd\x0d\x8e\xd9\x6c\x36\x8b\xf9\xb7\x05\xc4\x51\x11\x13\xd1\xb6\x88\x69\xc1\xc9\x37\x1e\xfe\x96\xf1\x5b\x75\xd5\xc3\xd7\x63\xaf\xa2\xf8\x6e\xc5\xb0\x20\x44\xb5\xd6\xf5\xeb\x0d\x8b\xc4\x2d\x98\x16\x64\x60\xb1\x21\x81\xfa\x41\xdf\x5d\x3b\xe7\x0a\xe2\xe4\xaf\xae\x7f\xa6\x7c\xef\xa9\xf1\x78\xbf\x5f\x00\x21\x76\x2f\xbf\xb7\x34\xa1\x05\x19\x9c\x44\x6e\x62\x5e\x2b\x82\x7b\xa7\x91\x86\x03\x61\x5e\xb8\x38\xd0\xd6\xa3\xaa\x1a\x67\x5a\xd8\x34\x9d\xb4\x42\x0a\xb4\x65\xd7\xd5\x8a\x52\x7c\xdb\x75\xd7\x00\x64\x96\x5a\x40\xcc\x5a\xc2\x98\xfa\x05\x67\x2d\xbc\xd6\x3e\xf1\x92\x4c\x6b\x9d\x33\x6d\x33\x15\xc9\x89\x39\xc8\xc7\x14\x12\x5e\x9e\x20\x83\x4b\x7b\x20\xe6\xdf\xc2\xd7\x78\x91\x22\x58\x1e\xd9\x1e\x9a\xfa\x9e\x8d\x42\x77\xaa\x04\x16\x40\x1b\x48\x00\x8d\x51\xde\xfa\xa8\xeb\xd6\x2c\xbc\x05\xd8\x0b\x33\x91\x60\xcb\x75\x41\xef\xb2\x82\x95\x1c\xc9\x74\xaf\x7f\x9b\x27\x18\x8e\x9b\x3b\x3d\xe0\x70\x54\x26\x45\xa7\xa8\x1c\x17\xd1\x68\xa6\xde\x71\x62\x7d\xfb\xc8\x81\x48\x1e\x2c\xef\x89\x08\x0e\xe3\x6d\xcf\x78\xfb\xac\xaa\x5a\x4b\x89\x31\x68\x1a\x1d\x6d\xdd\x5c\x80\x55\x00\xb5\xde\xae\xab\xa6\xc1\xb6\x36\x7e\x34\x36\x33\xd7\xd3\x9a\x46\x4e\x7b\x57\x61\xaa\x49\x18\x13\xf9\xa6\xea\xa8\xd9\x54\x28\x34\xeb\x6a\x65\xab\xf5\xd0\x33\x35\x95\x8c\x58\x47\x6b\x9d\xbd\xcf\x26\xeb\x03\x15\xb0\x14\x59\xfb\x49\x69\x24\x1d\x0e\x48\x6f\xbe\x6e\xa2\xdc\x06\xb6\xe8\xd2\x30\x01\x76\x97\x59\x17\x2f\x4e\xc4\xcf\xc4\xc0\xc1\xa2\x18\xdd\x0a\x77\x69\xaa\xb5\x0e\x59\x73\x87\xd7\x6c\x43\x7c\x11\x71\xab\xf0\x5d\xe5\xf7\x04\x17\xc8\x74\x1f\x00\xa0\xd3\xd7\x18\x0f\x20\x02\x14\x9c\xc1\xf1\x76\x01\x5a\x6a\xa2\x85\x32\x16\x3a\xfa\xba\x0a\x82\xa3\xc7\xd8\x9c\xda\x3c\xba\x07\xb9\xee\x5c\x45\x4d\x3b\xc3\x57\x4a\x7d\xb6\x48\x95\x60\x84\x41\xf9\x72\x6e\xcf\x96\x9e\x43\x28\x48\xc1\x41\x72\x79\x5f\xad\x7a\xa2\x69\xdc\xf4\x2a\x6e\xed\xb5\xff\xbf\x5a\xec\x47\x33\xb9\xf5\x8a\x00\xf9\x7e\x93\xf3\xac\xcc\x89\xea\x60\x77\x2f\x22\xa8\x39\x78\x05\x21\x68\xbd\xb1\xae\xc7\x2c\x42\xd8\x76\x4b\x82\x92\x43\xdb\xa2\xdb\x63\x46\xdd\x90\xf5\x02\x43\x96\xd5\xb2\x33\xd1\x52\xb0\xa3\x4d\x49\x5d\x9a\xdc\xe2\xbe\x55\x81\xbc\x7d\x44\x12\x7d\x3a\xab\xc4\x18\x31\x52\xfc\x1b\x87\x58\x60\x25\x49\xd8\xcb\x42\xfa\x5d\xd3\xa6\x91\x70\x34\x72\x4c\xdc\xb2\x62\x43\xec\x7d\xe1\x80\x3a\x79\x70\x09\x64\x47\xa1\xac\x13\xb8\x65\x27\xf5\xb6\xef\x68\x0d\x6a\xe5\x57\x51\x95\xc5\x56\xb6\x37\xb3\x2f\x96\x38\x76\xf7\xc1\x6e\xd8\xc3\x6a\x9b\x57\x9e\x15\x64\x64\x53\xb4\xec\xff\x9f\x85\xb1\x6f\xc6\xfa\x5e\xb0\x2c\x6d\xe4\x39\xe0\x3b\xd1\xfb\xfa\x31\x30\xed\x0f\x51\xdb\x30\xd3\xc9\x73\xd4\x41\x8a\x96\x7e\x15\x34\x44\x39\xaf\x28\xe3\x97\xa5\xbc\x96\xee\x39\x69\xb9\xa2\x8c\x5f\xe5\x59\x4c\x2a\xd1\x35\xc2\x5f\x9d\x42\x7a\x45\xc5\x6a\x93\xaf\xce\xd1\xaa\x07\xc5\xb5\x07\xbe\xbc\x26\xd4\x29\xa3\x65\x13\xf1\x92\x25\x84\x91\x44\x5e\xf7\x31\xe5\x9a\xe9\x24\x62\xb9\x98\xa6\xe6\x4d\x97\xee\x16\xcd\x02\xe2\x75\x49\x4b\x5e\x59\x14\x4b\x99\x44\x0b\xb8\x81\xe3\x6d\x20\x2e\xbd\x40\xad\x5c\x0a\x22\x78\x09\x37\xd0\x04\x5e\x5b\x0a\x0e\xc2\x20\x65\x3c\x14\xa0\xfc\xba\x46\x4e\x4d\x6f\x3d\x5b\xc0\xdf\x21\x2b\x78\x1f\xa8\x9e\xf6\x7b\xf6\x05\x5e\xb6\x4f\x7f\xff\xa2\x75\xd0\x05\x89\xb2\x9a\x02\x53\xce\x33\x40\xd5\x63\x0b\xb5\xaf\xdb\x3e\x23\x6d\xef\x98\x32\x6e\xc8\x12\x2a\xb6\xa8\xb8\xbf\xa5\x15\x01\x22\x8f\xe9\x2a\x1d\x63\xa8\x54\xcf\x02\x38\xd5\xb0\x54\xd8\xc1\x96\xf3\x1a\x18\x59\x45\x2c\xc9\x49\x55\xa9\x2e\x74\xc6\xe4\x9a\x70\x6f\x61\x6d\x85\x8d\xcb\xbb\x7e\x1f\xaa\xaf\x7a\x11\xa7\xb5\x97\xbd\x50\x7d\xbe\xf6\xee\x99\xae\x47\x44\x34\xde\xb9\x11\xd1\x3b\x6b\x17\xba\xbc\xdb\xb3\x09\x19\x9c\x8b\x2e\x46\x67\x82\xe7\x48\xac\x07\x09\xa5\xcf\x7b\x90\x16\x60\x95\x7b\x93\x13\x67\xd7\x4e\x3d\x46\xeb\xc1\x7b\xf5\x33\x89\x28\xd8\x97\x4f\xda\x97\x0c\x95\x67\xeb\xf0\xe1\xe1\x0f\xf1\xe9\xcb\x20\xc4\xe8\x06\x15\x86\x43\xb9\x8f\x60\x8d\x85\xff\x7a\x6d\x04\x3a\xd3\x63\x3e\x3e\x06\x2d\xa4\x36\x64\xfc\xfe\x05\xfb\xb6\xfe\xf1\x36\xf0\x40\x7a\x44\x3f\x40\xcf\x63\xfc\xa2\x40\x90\xa3\x04\x2d\xe1\x98\x80\xa9\x0e\x44\xdb\x03\x28\xb5\xc2\xfa\xa0\x66\x1b\x7a\x60\x4c\x40\xac\x3d\x03\x8f\x2f\xc0\xeb\xa2\x6b\x3f\xd9\xe9\xb6\xef\x84\x1d\x24\x11\x8f\x84\x06\xa4\x63\xe8\xed\xbd\x69\xb8\xe8\xac\xfb\xe6\x20\xd0\x13\xad\xd7\xf6\x7b\x09\xb3\xa8\x77\x92\xd9\x3f\x32\x54\xff\xfc\xe0\x85\x92\x0e\xd5\x99\xcb\x52\xf8\x7e\x53\x26\xa8\xf2\xb6\xbc\x88\xe5\x57\x16\xba\xb8\x40\x96\x9a\x86\x56\xe1\xfb\xbb\x24\x63\xe7\x79\xee\x1b\x06\xb0\xcf\x27\xe1\x05\x0b\xf8\xf1\x3f\xff\xfa\xd7\x20\xd8\x0b\x45\xe4\x0f\xd8\x6e\x54\x2b\x17\xd0\x86\xde\x1f\xff\xe3\x2f\x7f\x09\x6c\xc7\x43\xd5\xda\x9d\xc6\x4f\x24\x4a\xac\xb5\xc1\xd1\x2e\x64\x6d\x57\x71\x47\xc8\x49\xb2\x54\x7c\xf1\x15\xaf\xcb\x10\xdf\xd8\x51\xdb\xd8\x7d\xf0\x37\x39\xef\x85\x5d\x93\x4e\x09\x45\xeb\xac\x5a\x47\x3c\xbe\x05\xff\x04\x81\xc2\x0f\x2b\xca\x83\xe5\xff\x16\xc7\xd5\x2e\x7f\x43\x5c\x4f\x0a\x3f\x2e\x1e\x9e\x2f\xf4\xb4\xd0\x0f\x0d\x3b\xe2\x54\x00\xbf\x48\x13\xbb\x11\x0a\xc4\x3c\x4f\x8c\x3f\x06\xf7\xfe\xd8\xb3\xe7\xa2\xf2\x33\xa5\x38\xf3\x3c\xba\x21\x79\x37\x5c\xa4\xfd\x52\x45\x00\x95\x13\xed\xc0\x01\xae\xb0\x34\xec\xd3\x6d\x17\x18\xaf\x97\x67\xf0\xf2\x44\xbb\xca\xd2\x34\xea\x5f\xd0\xbb\xf6\x64\x66\x42\xb3\x4e\x13\xd2\x34\xa2\xc7\x29\xeb\x0e\x6c\x6f\x55\xa4\x48\xb2\x62\x75\x98\x5e\xb4\x32\x06\x9d\x76\x67\x6e\xb7\xc7\xdd\xb6\x4e\x37\x9b\xe4\x67\x2d\x57\x4c\x1e\x0c\x26\x4f\x76\xbd\x09\xbe\xb7\xd7\xf9\xb6\x87\x3b\x5d\xd7\xeb\xb6\x03\x6f\x3b\xc4\xdd\x1c\x52\x79\x94\xff\x6d\xf7\xfa\x9d\x6a\x1f\x63\xbd\x14\x9e\x63\x13\xd8\x17\x3f\xaf\x48\x4c\x8b\xe4\x90\x5e\x72\x4b\x72\x45\x0a\x0e\x05\xe5\xb7\xb8\xfd\xcb\xc6\xf2\x4f\xd5\x13\xac\x73\x34\x40\xfc\x4f\x45\x84\xb0\xdf\x13\x7e\x4b\xf5\xf7\x36\xbf\x44\x95\x18\x7c\xb6\x38\x31\xb2\xfd\xbc\xd0\x1e\x6d\xc2\xac\x26\xfa\x90\x6d\x66\x47\xd8\x69\xcf\x57\x84\xb6\x64\xd6\xb7\x63\xbe\xce\xed\x74\x1a\x81\xd4\xa8\xb5\x7b\xb3\x44\xbd\x8d\x3b\x25\x3f\x75\x13\x13\xcd\x45\xbe\x80\x51\xc1\x88\x43\xce\xe7\x90\x84\xe5\x0b\x8f\x14\xc8\x54\x3b\x74\xcb\x65\x64\xbf\x15\x02\x18\xe7\xfe\xe8\xe0\x20\xf0\xa7\x90\xc8\xd4\xed\x5c\x5c\x2a\x41\x1f\x52\x8e\xda\xff\x0e\xf5\x04\xe6\x4c\x7d\xc1\xef\xa8\x2c\x0e\xf1\x58\xdd\x0b\x1b\x62\xe9\x6d\xfc\x1a\x96\x80\xa4\x6e\xc2\x1c\x92\x08\x80\xba\x9b\xe0\x23\x99\x73\x16\xb4\x30\x5a\x49\xec\xed\x98\x88\x43\x67\x51\xb6\xe3\xd7\x27\x76\x49\xad\x0c\xc9\x5c\xd2\xc1\xed\xd5\xc8\xdd\x1a\x9e\x1e\x6f\xda\x20\x6d\x55\x91\x13\xa3\x43\x8b\x70\xe1\xa2\xa2\x13\x31\x5c\x07\x2b\xe3\x09\x44\x0f\x72\x0f\xdb\x63\x53\x78\x8b\xd7\x67\x4a\xe6\xfb\x46\xfe\x84\xf4\x7e\x37\xcb\x0b\x98\xc4\xd6\xa3\x93\xf2\x03\x14\xba\xaf\xb6\x98\x04\xea\xd0\xd0\xf7\xcf\xe3\x71\x57\x93\x6e\x47\x0b\xfa\xe9\x29\x85\xf5\x1d\xa5\x75\xa7\x42\x12\xfd\x59\x1e\x30\xf4\x3e\xb7\xec\x46\x13\xec\xf8\x92\xaf\xff\xae\x86\xf1\xfa\xf6\xdd\x02\xb6\xf6\x85\x6f\x09\xcb\xea\x0e\xd8\xb0\xcf\x20\x2a\x4b\x52\x24\x7e\x77\x7c\x01\x1d\x94\x35\xc2\xec\x79\x76\x4f\x18\x88\xf9\x19\xd0\x6e\x1d\x48\x7a\x7e\xd6\x6b\x11\x1d\x6f\xe5\x5a\x5d\x89\x1d\x8d\xe9\x71\x8e\x4d\xd2\x54\x7f\x1c\x89\xff\xa1\x07\x3e\x7f\xa4\x59\xc1\x09\xab\x46\xae\x2e\xca\x88\x2d\x56\x0e\x23\xb2\xbe\x5c\xf5\x8f\x7f\xb4\x36\x35\xbc\x70\x35\x5e\x4a\x19\x38\x76\x3c\x3f\xb0\x6a\xfa\x57\x4e\x1b\x67\x13\xc2\xe3\x84\xf6\x87\xae\xb0\xf7\x16\x62\xbb\x2b\x31\x07\x99\x8f\x29\xc8\xfe\x4c\xb9\x58\xcf\x0d\xba\xd7\x10\x5c\xce\xf4\xbd\xb8\x61\xa9\xe4\xad\xbb\xb4\xdd\x97\x12\xc3\xa8\x97\x3d\xaa\x49\x67\x30\xaa\x43\xa3\x27\xf4\xeb\xa6\x2b\xe8\x5f\xa1\xb3\x37\xd9\xb4\xa7\x6c\xbe\x4f\xe9\xeb\xfd\x99\x6b\x0c\x67\x98\x7e\xdc\x9d\x51\xc7\xa5\x31\x79\x8e\x2c\x6f\x8e\x05\x8f\xbd\x3a\x76\x41\x8b\x78\xc3\x18\x29\xe2\x07\xf3\x6d\xe0\x6c\x26\xbf\x63\xf2\x62\xfd\x92\x7b\xee\xef\x82\xcc\xad\x83\x4f\x9b\x42\x9e\xc8\xae\xa8\xfc\x90\xe4\x84\x45\x31\x01\x4e\x21\x21\x9c\xc4\xf2\xa3\x13\x3c\x89\x00\x1c\xd7\xa7\x7d\x80\xc4\x57\xea\xac\x0f\xf3\x8b\xfb\x15\x60\x4b\x35\xfc\x2d\xca\xf8\xcf\x8c\xaa\xff\x62\x43\x6c\xe1\x19\x3a\xe9\x8f\x7f\x83\x0c\x5e\xc2\x4f\xf8\xef\x0f\x3f\x68\xfc\xb3\xfb\x55\x78\x9e\x24\xfe\x4f\x3a\xb8\x0c\x6e\x5e\xea\xbb\x97\xf7\x2b\x75\x05\xd1\x4e\x97\xc7\x2e\xf0\xef\xba\x9b\x30\xe9\x1a\xff\x9e\xab\xfc\xd3\x2e\xf3\xbb\x92\x0d\xd7\xc0\xe8\x25\xcd\xf6\x9a\xa6\x36\xbd\xfb\x95\x10\xb0\x1a\x6d\x02\xd7\xff\x22\xd1\xfb\xb0\x0d\x9a\xc0\xfd\x0d\x18\xde\x1b\xee\x7e\xff\xd5\x1c\x89\xff\x02\x4d\x02\xfb\xbf\x01\x00\xd7\xf7\xb8\xfa\x0d\x4f\x00\x00")
 
 func templatesFunctionTmplBytes() ([]byte, error) {
 	return bindataRead(
 		_templatesFunctionTmpl,
 		"templates/function.tmpl",
-		true,
 	)
 }
 
@@ -124,18 +162,57 @@ func templatesFunctionTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/function.tmpl", size: 2375, mode: os.FileMode(420), modTime: time.Unix(1479275664, 0)}
+	info := bindataFileInfo{name: "templates/function.tmpl", size: 20237, mode: os.FileMode(420), modTime: time.Unix(1792025096, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesFuzzTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x51\xbd\x6e\xdb\x30\x10\x9e\xc9\xa7\xb8\x0a\x1a\xa4\xc2\xe5\x03\x18\xd0\xd0\xa1\x1e\x8b\xd6\xf6\x94\xc5\x10\xac\xa3\x43\x44\xa6\x05\x8a\x4a\x60\x1d\xee\xdd\x83\xa3\xe5\x9f\x38\xc9\x92\x4c\x94\x8e\xfc\xfe\xee\x23\x6a\xd0\x3a\x8f\x90\xd9\x61\x1c\x33\x66\x4d\xf4\x0b\x72\x0b\xf3\x0a\x0c\xb3\xd6\x76\xf0\x5b\x20\x32\x8b\x61\x1c\xff\xd6\x7b\x64\x2e\x2c\xfc\x8c\xd8\x47\xe7\x77\x66\x51\x02\x69\x65\xcd\xef\xa6\x29\x88\x42\xed\x77\x08\xb9\x9b\x41\x8e\x6d\x62\xf8\x57\x87\x7a\x8f\x11\x43\xcf\x4c\xe4\x2c\xe4\x8e\x79\x06\x44\xe8\x1b\x99\xac\x10\x1b\xd1\x99\x06\xa5\x70\x89\x52\x21\xb2\x45\xbc\x0a\xad\x67\xf0\x15\xfe\x74\x2f\x02\x12\x61\x7d\xec\xf0\x2a\x25\xc6\x95\x84\x7d\x71\xf1\x11\xcc\x12\xb7\xe8\x9e\x31\x30\x6b\xa5\x14\xd1\xf9\x3f\x81\xe7\x95\xe0\x1f\x30\x1c\xd2\xb5\xa0\x12\x49\xfa\x76\x56\xd0\x71\x08\xbe\xff\x13\xc2\x21\x88\xc4\xc9\xa9\x59\x62\x3f\xb4\xb1\x67\xde\x5c\x3c\x61\x08\xb2\x19\x22\x6c\x7b\x04\x67\x6f\x1e\x7d\x14\xf0\xe6\xf2\x3e\xdd\x66\x3a\xa1\x3a\x4f\x3e\x09\xf4\x36\x8c\x39\x49\x8b\xcb\x94\x3c\xb7\xe6\xff\x50\xb7\xce\x3a\x09\x4f\x34\x3d\x11\x85\xcb\x61\x4e\xcd\x13\x45\xdc\x77\x6d\x1d\x11\xb2\x78\xec\xb0\x0e\xbb\x3e\x13\xce\xe2\x5b\xdd\x4c\x93\x72\xb2\xff\x7e\x9f\xd2\x88\xb3\x20\xab\xfb\x51\x81\x77\x6d\xea\x4e\xa9\x68\x56\x4f\xae\x2b\x30\x04\xc1\xaa\xbb\x6a\xb8\xd4\xac\x35\x11\xfa\x86\x59\xbf\x0e\x00\x0a\x3e\x06\x41\xe9\x02\x00\x00")
+
+func templatesFuzzTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesFuzzTmpl,
+		"templates/fuzz.tmpl",
+	)
+}
+
+func templatesFuzzTmpl() (*asset, error) {
+	bytes, err := templatesFuzzTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/fuzz.tmpl", size: 745, mode: os.FileMode(420), modTime: time.Unix(1792000625, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesHandlerTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x54\x4d\x6b\xdb\x4c\x10\x3e\x4b\xbf\x62\x30\xc9\x8b\xf4\xa2\x6c\x7a\x36\xf8\x90\x3a\x29\x94\x42\x42\xe3\x90\x4b\x29\x65\x23\x8d\x6c\x81\xbc\xb2\x77\x47\x31\x66\xd9\xff\x5e\x66\x57\x96\x25\xdb\x81\xd2\x43\x4f\xf6\x8e\xe6\xe3\xf9\x98\x5d\x6b\x0b\x2c\x2b\x85\x30\x59\x49\x55\xd4\xa8\x27\xce\xc5\xd6\xde\xc0\x55\x09\xd3\x19\x08\xe7\xe2\xd8\xda\x5d\x45\x2b\x90\xaa\x00\x31\x6f\x36\xfb\xfb\x26\x07\x71\xdf\xe4\xce\x59\x3b\x6f\xd6\x6b\x54\xc4\x89\xd6\xa2\x2a\xe0\xc6\xb9\xb8\x6c\x55\x0e\xd6\x8a\x17\x34\xf4\x28\xd7\xe8\x5c\x42\xf0\x3f\xa1\xa1\x4a\x2d\xc5\x4b\x0a\x36\x8e\x78\x46\x55\x82\xb8\xab\xeb\x66\xf7\xa0\x75\xa3\x9d\x8b\x23\xb3\x6a\xda\xba\xe0\xc9\xd2\x18\xd4\x24\x1e\x71\x97\x50\x1a\xd2\xb1\x36\x38\x4e\xd2\xb8\x6d\x2b\x8d\xe3\x2c\x55\x70\x12\x17\x78\xd8\xe2\x19\x73\xac\xde\xd1\xf7\x3f\x8c\xf5\x5c\xbe\x9a\x05\xe9\x36\x27\x10\x5f\x2a\xac\x0b\xe3\x13\x22\xda\x6f\x10\x4a\x1f\x00\x13\xbe\x5b\x8e\x73\xa5\x96\x6a\x89\xe3\xf4\xc8\x5a\x7f\x64\x05\x3c\xe7\xfd\xc6\x83\x8c\x86\x60\xa2\xc8\xc5\xa3\xc0\xe8\xaf\x98\x4b\x83\xaf\x52\x07\xa9\x98\xd7\x8f\x9f\x83\xc9\x4a\xae\x91\x91\x54\x6a\x19\x47\x1f\xf1\xfa\x03\x62\x51\x47\x2a\xfc\xf4\x08\x6b\x83\x5c\xa8\x1a\x3a\x16\xf6\xd4\x0e\x33\x86\xec\xc4\xab\xac\xdb\x0b\x1c\x87\xff\xd7\x48\xab\xa6\x00\x38\xe2\x26\xa9\x97\x48\xc3\xc8\x4e\x2a\x9a\x37\x05\x42\xa5\xa8\x3b\x7e\x6e\x8a\x7d\x9f\xe0\x58\xf6\xdb\x5b\x78\x79\xba\x7f\x9a\xc2\x5d\x51\x00\x6f\x10\xe4\xd2\xa0\x11\x31\x2b\x5a\x36\x1a\x7e\x65\x40\xc4\x9a\x05\x6f\xce\xd4\xb4\x1d\x32\xde\xb5\x45\xfb\xc6\x2d\x0c\x38\x47\xe2\xb9\x55\x09\x91\x60\x75\x33\xe0\x8d\x3d\xdd\x51\xe8\x08\xc1\xcd\x91\xeb\x25\xe5\xfb\xf6\x27\xea\x9d\xca\x37\x9d\x81\xb5\x0c\xc3\x8b\xc8\xc9\x52\x3b\xf7\x9f\xbf\x36\xce\x9d\x88\x6b\x0f\x2d\x2e\x2f\x1d\xa3\x11\x81\xe1\x14\x88\x44\xf0\x54\x0c\x76\x31\x3b\x36\x38\x98\xd2\xad\xe1\xd8\xf7\x31\x9a\x8f\x80\x13\x89\x71\x30\x3e\x6b\x3e\x3a\x54\x25\xa3\xea\x1d\x9e\xcd\xe0\x93\xdf\xe5\x28\x1a\x85\x61\x45\xb4\x11\x0b\x92\xd4\x9a\xa7\x6f\x87\x7b\x12\x69\xdc\xf2\x50\xfe\xc8\x86\xf0\xf5\x7e\xc6\x6d\x8b\x86\xd8\xb1\xb0\x5b\x6c\xbb\x08\x4b\x95\x81\xaa\xea\x94\xab\x35\xe6\xe7\x85\x79\xa3\x0b\xd4\x89\x4f\xb0\xf6\xd4\x41\x6f\x09\x2f\x7f\xd2\xe8\xa3\x85\x23\x59\x52\xe7\x3c\xff\xce\xa7\xa1\x0c\x1c\xf5\xaf\x52\xd7\xf8\xaa\x14\xdf\x5b\x59\x57\x65\x15\x7a\x77\x29\xa1\xf0\xe0\x73\xf7\x24\x6a\xcc\x33\xd0\xb8\xf5\xc0\xc2\x9b\x26\x1e\xb6\xad\xac\x13\x8d\xb9\x60\xdd\xb2\xa1\x88\xc1\xd0\x72\x4d\x62\xb1\xd1\x95\xa2\x32\x99\xf4\xd8\xfb\xcd\x76\xee\x7a\x2b\xa0\x1f\x75\x4e\x76\xb4\x64\xe2\x0c\x53\x0a\x79\x30\xe6\xfa\x3d\x03\xb6\x0f\xae\xdf\x27\x19\x5c\x1a\xd4\xdf\x9d\xae\xc9\x45\xd0\xe9\x65\x72\x7c\xcf\xc5\xc2\xdf\xf3\x24\xed\x0b\x38\xfa\x8f\x58\xbe\xf1\x43\xf3\xb7\x2c\x3f\x46\xef\xe9\x5e\x78\x6d\xc0\xa5\xe3\xd7\xc4\xc5\x2e\x8e\xad\x45\x55\x38\x17\xff\x1e\x00\x3d\x73\x6d\xd1\x84\x07\x00\x00")
+
+func templatesHandlerTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesHandlerTmpl,
+		"templates/handler.tmpl",
+	)
+}
+
+func templatesHandlerTmpl() (*asset, error) {
+	bytes, err := templatesHandlerTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/handler.tmpl", size: 1924, mode: os.FileMode(420), modTime: time.Unix(1792000749, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesHeaderTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xcc\x31\x0a\xc2\x40\x10\x85\xe1\x7e\x4f\xb1\xa4\xd2\x66\x2f\x61\x65\x23\x5e\x61\x70\x9f\x49\x90\x99\x84\x75\xbb\xc7\xbb\xbb\x90\x05\xb5\xfb\x99\xe1\x7b\x64\xc5\x73\x0d\xe4\x69\x81\x55\xb4\x49\x4a\x64\xb3\x98\x91\xcb\x65\x73\x47\xf4\xb7\x44\x96\xe3\x81\xa8\x52\xda\xed\xf1\xb2\x19\x99\x2c\xf7\x91\x52\x4a\xab\xef\x5b\xeb\xf9\xf4\xf3\xd7\xe3\x32\xf8\xcd\x1c\xd2\x20\x7d\xf9\x1b\x3b\x7f\xeb\x13\x00\x00\xff\xff\x18\xfd\x24\x71\x8c\x00\x00\x00")
+var _templatesHeaderTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8d\x31\xce\xc2\x30\x0c\x46\x77\x9f\x22\xea\xf4\xff\x4b\x0e\x41\x27\x16\xc4\x15\x2c\x62\xda\x08\xe2\x56\x69\x10\xc3\xa7\xef\xee\x08\x0a\x15\x12\x9b\xe5\xf7\x9e\x0d\x24\x3b\x67\xb7\xd0\x8d\xa6\xc9\x6a\x47\x0a\x70\xcf\x6d\x0c\x71\xa7\xee\x56\x49\x20\x92\x22\x80\x79\x22\x37\x7a\xcb\xd7\xd4\x4f\xbe\xb4\xaa\xd9\xdb\xaf\x56\xd5\x07\x0b\xb1\x9f\x4a\x31\x6f\xcb\x47\x78\x73\x99\xf5\x74\xd1\xc1\x02\x10\x8f\xeb\xf8\x7c\x92\xcb\x3c\xd5\x16\xfe\x64\xeb\xf7\xaf\xcd\x9a\x1f\xb4\x18\xb9\x26\x6d\xfc\x3a\xf6\x2f\x80\x79\x22\xe5\x31\x00\x3b\xe0\x09\x5e\xd1\x00\x00\x00")
 
 func templatesHeaderTmplBytes() ([]byte, error) {
 	return bindataRead(
 		_templatesHeaderTmpl,
 		"templates/header.tmpl",
-		false,
 	)
 }
 
@@ -145,18 +222,17 @@ func templatesHeaderTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/header.tmpl", size: 140, mode: os.FileMode(420), modTime: time.Unix(1486648034, 0)}
+	info := bindataFileInfo{name: "templates/header.tmpl", size: 209, mode: os.FileMode(420), modTime: time.Unix(1791998423, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesInlineTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xaa\xae\x4e\x49\x4d\xcb\xcc\x4b\x55\x50\xca\xcc\xcb\xc9\xcc\x4b\x55\xaa\xad\x55\xa8\xae\x2e\x49\xcd\x2d\xc8\x49\x2c\x49\x55\x50\x4a\x4e\xcc\xc9\x51\x52\xd0\x03\x8b\xa6\xe6\xa5\xd4\xd6\x02\x02\x00\x00\xff\xff\xaa\xeb\x41\xff\x31\x00\x00\x00")
+var _templatesInlineTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x31\x00\xce\xff\x7b\x7b\x64\x65\x66\x69\x6e\x65\x20\x22\x69\x6e\x6c\x69\x6e\x65\x22\x7d\x7d\x20\x7b\x7b\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x63\x61\x6c\x6c\x22\x20\x2e\x7d\x7d\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x03\x00\xaa\xeb\x41\xff\x31\x00\x00\x00")
 
 func templatesInlineTmplBytes() ([]byte, error) {
 	return bindataRead(
 		_templatesInlineTmpl,
 		"templates/inline.tmpl",
-		false,
 	)
 }
 
@@ -171,13 +247,12 @@ func templatesInlineTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesInputsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8d\x31\x0a\x02\x41\x0c\x45\xaf\x12\x96\x2d\x25\x07\x10\x3c\x80\x9d\xe0\x09\x22\x9b\x59\xa6\xd8\x28\x99\x6c\xf5\xc9\xdd\x65\x46\x8b\xa9\x12\x1e\xff\xbf\x0f\x6c\x5a\xaa\x29\x2d\xd5\x3e\x67\xb4\x25\x13\x58\x0b\x5d\x6f\xc4\xfd\xad\x85\xec\x1d\xc4\xcf\xf3\x15\xda\xa2\x65\x46\xb0\xc9\xa1\x17\x02\xd4\xb6\x7f\x66\x2d\xfc\xf0\x6a\x71\x1f\x92\x0e\x5d\x6c\xd7\xc1\xc5\xe5\xd0\x50\xff\x75\xc5\xf7\xc6\xc0\xa0\x7d\x62\xf2\xcc\xe7\x1b\x00\x00\xff\xff\x8e\xbc\xcf\xda\x98\x00\x00\x00")
+var _templatesInputsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\x31\xaa\xc3\x30\x0c\x86\xaf\x22\x4c\xc6\x87\x0e\xf0\xa0\x43\xc7\x2e\x25\x90\x5c\xc0\x69\x7e\x07\x43\xec\xa6\xb6\x32\x14\xa1\xbb\x97\x98\x0c\xed\xa4\x4f\x42\xfa\xf4\xab\xce\x08\x31\x83\x5c\xcc\xdb\x2e\xd5\x99\xa9\x76\x81\xfe\x2f\xc4\x07\xc6\x40\xf9\x29\xc4\xc3\x3e\x09\xaa\xd4\x73\x86\x17\xf1\xe8\xa7\x15\x83\xbc\x57\x90\x4b\x7e\x73\x66\xd9\x27\xa8\x62\xad\x30\x13\xe1\xb3\xcd\xb3\xd9\x1f\x9d\xd0\xae\xbb\xc0\x7d\x89\x59\x6e\xed\xe5\xa1\x2c\x3e\x2f\xa0\x2e\xf0\x88\x2a\xbd\x2f\x3e\x41\x50\x6a\xd3\x1c\x79\xf8\x5a\x96\x3a\x48\xd9\x1f\x72\xf7\x09\x66\xac\xda\xd6\x88\x7f\xe4\xdf\xe5\x33\x00\xd9\x2f\x00\xb6\xdb\x00\x00\x00")
 
 func templatesInputsTmplBytes() ([]byte, error) {
 	return bindataRead(
 		_templatesInputsTmpl,
 		"templates/inputs.tmpl",
-		false,
 	)
 }
 
@@ -187,18 +262,17 @@ func templatesInputsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/inputs.tmpl", size: 152, mode: os.FileMode(420), modTime: time.Unix(1479275664, 0)}
+	info := bindataFileInfo{name: "templates/inputs.tmpl", size: 219, mode: os.FileMode(420), modTime: time.Unix(1791999605, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesMessageTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8d\xe1\x6a\x83\x40\x10\x84\xff\xfb\x14\x8b\x28\xb4\xa0\xfb\x00\x85\x3e\x40\xff\x14\x69\x4b\xff\x5f\xe2\x68\x16\xf4\x62\xee\x4e\x43\x58\xf6\xdd\x83\x07\xf1\xd7\x0c\x33\xc3\x37\xaa\x3d\x06\xf1\xa0\x72\x46\x8c\x6e\x44\x49\xad\x59\xa1\x2a\x03\xf9\x6b\x22\xfe\x5d\x4f\x09\x31\x45\xb3\xfa\xc6\xa4\x0a\xdf\x9b\xa9\xde\x25\x5d\x88\x7f\x70\x86\x6c\x08\x7b\xc2\x7f\x8f\x05\xfc\xef\xa6\x15\x66\x7c\x0c\xf9\xdb\xcd\x30\x7b\xcb\x44\xee\x82\xf8\xf4\xe5\x97\x75\x07\xaa\x06\xe7\x47\x50\x25\x0d\x55\x98\xe8\xe3\x93\xb8\x73\xc1\xcd\x48\x08\xb9\x97\x81\x2a\x31\x6b\x5e\xbf\xf5\x76\x70\xb3\xbc\x17\xaa\x2d\x65\xfb\x0c\x00\x00\xff\xff\x90\x2e\xb9\x52\xc9\x00\x00\x00")
+var _templatesMessageTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8d\xd1\x4a\x86\x50\x10\x84\xef\x7d\x8a\x45\x14\x0a\x74\x1f\x20\xe8\x01\xba\x09\x29\xe9\xfe\x94\xa3\x2d\xe8\xc9\xce\x59\x8d\x58\xf6\xdd\x7f\x14\x7e\xaf\x06\x86\x6f\xbe\x31\x1b\x30\x4a\x04\x95\x0b\x72\x0e\x13\x4a\x6a\xdd\x0b\x33\x19\x29\xfe\x28\xf1\xfb\xf6\xa9\xc8\x9a\xdd\xeb\x5f\x26\x33\xc4\xc1\xdd\xec\x4f\xf4\x9b\xf8\x0d\x5f\x90\x1d\xe9\x68\xb8\xff\x5f\xc1\x1f\x61\xde\xe0\xce\x17\xc8\xaf\x61\x81\xfb\xc3\x69\xe4\x2e\x49\xd4\x97\xb8\x6e\x9a\x8f\x4d\x0a\x71\x02\x55\xd2\x50\x85\x99\x9e\x9e\x89\x7b\x64\xed\x42\x0a\x0b\x14\xe9\x64\x64\xa4\x4a\xdc\x9b\xfb\x77\xbd\x5f\xee\x33\x1e\x0b\xb3\x96\x10\x07\xf7\xdb\x00\xa0\xed\x2b\x02\xcd\x00\x00\x00")
 
 func templatesMessageTmplBytes() ([]byte, error) {
 	return bindataRead(
 		_templatesMessageTmpl,
 		"templates/message.tmpl",
-		false,
 	)
 }
 
@@ -208,18 +282,57 @@ func templatesMessageTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/message.tmpl", size: 201, mode: os.FileMode(420), modTime: time.Unix(1479275664, 0)}
+	info := bindataFileInfo{name: "templates/message.tmpl", size: 205, mode: os.FileMode(420), modTime: time.Unix(1791995396, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesMockTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\xc1\x6e\xeb\x20\x10\x45\xd7\xe6\x2b\xae\x22\x16\xc9\x4b\xe2\xec\x9f\xd4\x55\xd7\xa9\xaa\xaa\x3f\x80\x6c\xdc\xa0\x04\x6c\x01\x5e\x44\x57\xf3\xef\x15\x58\xaa\xdc\x34\x3b\x66\xb8\x73\x0e\x03\xd9\xdb\xc1\x05\x8b\x8d\x1f\xbb\xeb\x46\x44\x91\x47\x68\x8f\xff\x2f\x68\x45\x94\x3a\x9d\x40\xb6\xe7\xb1\xbb\xbe\x19\x6f\x45\xe0\x12\x0c\x4a\x18\xe3\x50\xae\x3e\xef\x53\x69\xe7\x8b\xc9\xe8\xc6\x39\xe4\x84\x7c\xb1\xe8\xcc\xed\x96\x4a\xc4\xe5\x04\x6f\xf3\x65\xec\x53\xab\xf2\x7d\xb2\x0f\xbc\x94\xe3\xdc\x65\x50\x35\xc5\x1c\x4d\xf8\xb2\xa8\xd0\xf6\xbc\x4c\x89\xa8\xa6\x21\xdb\x25\xff\x5a\xb9\x2e\xe4\x25\x6f\x43\x2f\xa2\xca\xab\x9f\x4f\x0e\x73\xe8\xb0\xf5\xf8\x47\x6a\xbf\xd2\xee\xf0\x43\xdc\xae\xcd\xda\x1d\xa0\xa7\xba\xfd\xbb\x89\xc6\x27\x11\xd2\x0d\xd0\x4e\xe4\x00\xb2\xfa\x48\x3d\x95\x7e\x2d\x76\xf8\x0b\x88\x15\xf0\x61\xd3\x7c\xcb\x4f\x09\x91\x2c\x35\x48\x1d\x57\x24\xaa\xc6\xb7\xbf\x57\xdd\xef\x17\xba\x1b\x56\x40\xd5\x44\x9b\xe7\x18\x1e\xff\x60\x39\x91\x47\xd8\xd0\x8b\xa8\xef\x01\x00\x14\xba\x6c\x13\xde\x01\x00\x00")
+
+func templatesMockTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMockTmpl,
+		"templates/mock.tmpl",
+	)
+}
+
+func templatesMockTmpl() (*asset, error) {
+	bytes, err := templatesMockTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/mock.tmpl", size: 478, mode: os.FileMode(420), modTime: time.Unix(1791995627, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesPanicsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x91\xcf\xca\xa3\x30\x14\xc5\xd7\xe6\x29\xce\x84\xb6\x28\xa8\x0f\x30\xe0\xa2\x8b\x2e\x0b\x03\xf3\x04\x52\x6f\x9c\x80\x46\x9b\xc4\x96\x21\xdc\x77\xff\x88\x6d\x45\xbf\x4d\x29\xdf\x4e\xef\x9f\x73\xee\xef\x24\x84\x86\x94\x36\x04\x39\xd6\x46\x5f\x9c\x64\x16\x21\x14\xd8\x29\xfc\xae\x50\xbe\xfe\x3c\x39\xaf\xd5\xff\x58\xa3\x2b\xca\xa3\x73\x64\xbd\x1e\x0c\xe4\xb3\xb3\xec\xd5\x73\x2b\x0e\x4a\x4b\xd7\x49\x5b\x92\xcc\x21\x68\x85\xf2\xd8\x75\xc3\xfd\x64\xed\x60\x63\xe5\x35\x59\x41\x3e\xbe\xe6\x39\x32\x0d\x0a\x66\xd1\x90\x22\x0b\x35\x99\x4b\x9a\x21\x88\xc4\x46\x45\x4b\x97\xe1\x46\x36\xcd\x44\x12\xbd\xb4\x5a\x0e\x63\x16\xc9\x22\xc9\x5c\x9e\xae\x53\xdd\xa5\x3e\x87\xf7\xe5\xbd\x36\xfe\x4f\x84\xcb\x61\xf1\xab\x82\xd1\x5d\x08\x9e\xfa\xb1\xab\x3d\x2d\x00\xbd\x6b\x25\x76\x8a\x39\x13\x89\x56\xcb\x24\x0e\x87\x8d\xc6\xd9\xb5\xb1\x23\x65\x3c\xea\xbd\xe5\xd9\xb5\x39\x54\xef\xcb\xbf\xa3\xd5\xc6\xa7\x36\x7b\x63\x3d\x73\x14\xa0\xce\x51\x64\x72\xff\x86\xa9\x6b\x9e\xda\xaf\x9b\xbe\x51\x89\x04\xc0\xca\x44\xa5\x72\x6d\xd2\x93\x73\x75\x4b\x0f\x07\xcc\xaf\x8c\x0a\xfb\x5b\x8e\x45\x02\xfb\x9b\xcc\xb1\x5e\xd2\x66\x9c\xbc\x7b\xee\xd8\xad\x61\xf6\x49\x42\x1b\x80\x4d\x10\x5b\xd1\x18\x94\x48\x7e\x4c\x12\xcd\x3f\x82\x39\xbb\x36\x5b\xc5\x6e\x1a\x66\xc1\x69\x26\x42\x28\x40\xa6\x61\x16\x5f\x03\x00\x62\x34\xb1\xea\x22\x03\x00\x00")
+
+func templatesPanicsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesPanicsTmpl,
+		"templates/panics.tmpl",
+	)
+}
+
+func templatesPanicsTmpl() (*asset, error) {
+	bytes, err := templatesPanicsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/panics.tmpl", size: 802, mode: os.FileMode(420), modTime: time.Unix(1791999213, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesResultsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8d\x4d\x0a\xc2\x40\x0c\x85\xaf\xf2\x28\xb3\x2c\x3d\x80\xe0\x52\xdc\x7b\x03\xa1\x19\x09\x94\x0c\xbc\x99\xae\x42\xee\x2e\xa9\x45\xc1\x55\x7e\xbe\x2f\x79\xee\xab\x54\x35\xc1\x44\xe9\xfb\x36\xfa\x14\x01\x77\x3e\xed\x25\x28\x3a\xa3\xc8\x86\xcb\x15\xcb\xe3\x83\x23\xdc\xb5\xa2\x68\xc4\x0c\x77\xb1\x35\x37\xf7\x36\xb0\x64\x73\xce\x5a\xf3\x60\xec\xb4\x7e\x23\x1b\x53\x16\xf2\xe4\x38\x84\xc6\xef\xd3\x7f\x39\x03\x7f\xee\x51\xdf\x01\x00\x00\xff\xff\xb0\x4f\xcf\x61\xa8\x00\x00\x00")
+var _templatesResultsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8d\x41\x0a\x02\x31\x0c\x45\xaf\xf2\x19\xba\x1c\xe6\x00\x82\x4b\x71\xef\x0d\x84\xa6\x12\x18\x52\x48\x3b\xab\xf0\xef\x2e\x55\xa9\x30\xcb\xe4\xbd\xbc\x44\x64\x29\x6a\x82\xc5\xa5\x1d\x7b\x6f\x0b\x89\x08\x7f\xda\x4b\x90\x74\x45\x92\x1d\x97\x2b\xb6\xc7\x17\x93\x11\x5a\x90\x94\x5c\x11\x21\x96\xc7\xe6\x5e\x3b\x36\x72\xce\x5a\xc6\x41\x3f\xdc\xda\xcd\xbd\xfa\x90\xc5\xfd\xc7\xf1\x49\x54\x9f\xd1\xb3\x3c\x1e\xfe\x5d\xb1\x4c\xbe\x07\x00\xb0\x4f\xcf\x61\xa8\x00\x00\x00")
 
 func templatesResultsTmplBytes() ([]byte, error) {
 	return bindataRead(
 		_templatesResultsTmpl,
 		"templates/results.tmpl",
-		false,
 	)
 }
 
@@ -234,6 +347,146 @@ func templatesResultsTmpl() (*asset, error) {
 	return a, nil
 }
 
+var _templatesShouldTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\x41\x4a\x04\x31\x10\x45\xf7\x73\x8a\xcf\xac\x3a\x8b\xce\x01\x04\x17\x32\x8a\x3b\x91\x71\x2e\x10\x4c\x05\x03\xb1\x32\x56\x55\x18\x21\xe4\xee\x22\xd3\x36\x4a\x23\xb5\x2a\xfe\x7f\x9f\xd7\x7b\xa4\x94\x99\xb0\xd7\xb7\xda\x4a\xdc\x8f\xb1\x03\x80\xde\x67\xe4\x84\x2a\xf0\x47\xb2\x26\xac\x0f\x22\x55\x30\x05\x8e\xf0\x27\x52\x3b\x92\xb6\x62\x8a\x89\xab\xc1\x1f\xde\xcf\xf7\x39\x25\xe7\xe0\x9f\x03\xe7\x57\x5d\x9a\x8f\xb5\x44\xe2\x75\xe4\x44\x9f\xe6\x96\xe8\x10\xce\xd6\x84\x5e\x2c\xd6\x66\x9b\x99\xf9\xaf\x88\xbf\x2b\xa5\x5e\xae\x0e\x3f\xd1\xf7\x5d\xad\x71\x73\x8b\xa0\x4a\x62\xfe\x89\x2e\x93\xb9\x15\xa5\xa2\xf4\x0f\x20\xf4\xd1\xb2\xd0\x86\xe0\xb8\x02\xbf\xff\xde\x67\x10\xc7\x31\x76\x5f\x03\x00\xfc\x63\x74\x9e\x34\x01\x00\x00")
+
+func templatesShouldTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesShouldTmpl,
+		"templates/should.tmpl",
+	)
+}
+
+func templatesShouldTmpl() (*asset, error) {
+	bytes, err := templatesShouldTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/should.tmpl", size: 308, mode: os.FileMode(420), modTime: time.Unix(1792004049, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesStdoutTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xcd\x6a\xdc\x30\x10\x3e\x57\x4f\x31\x15\x31\xd8\xd4\xab\x07\x48\xf1\x21\xb4\xe9\xad\xf4\x90\x6b\x2f\x6a\x3c\x72\x04\x96\xe4\x95\xc6\x59\x8a\x98\x77\x2f\x92\xbd\x66\x29\x6c\xe9\xcd\x1a\x7d\x7f\xf3\xc9\x39\x8f\x68\xac\x47\x90\x89\xc6\xb0\x92\x64\x16\x39\x9f\xe0\xc1\xc0\xe3\x00\xea\x7a\x22\x4c\x64\xcd\xef\x32\xc3\x33\xa8\xa7\x94\x30\x92\x0d\x1e\xe4\x7e\x73\xf0\x74\xbd\x2a\x40\x19\xf1\xbc\xda\x88\x92\x39\x67\x6b\x40\x3d\xcd\x73\xb8\x3c\xc7\x18\x62\x99\x5c\x91\x03\xc8\xed\xab\xe2\xd0\x8f\x57\xa5\x77\x8c\xbf\x8a\xce\x12\xad\x27\x03\xb2\x69\x9a\x77\x09\xea\x3b\xa6\xa4\x27\xfc\x16\xa2\xd3\x04\x27\x66\x31\x05\xfa\xb1\xd2\xb2\x52\x41\x47\xd4\xe3\x4b\x5d\xa5\xed\xaa\x4e\x71\xfe\xe2\x96\xaf\xd6\x18\x66\x61\x0d\x8c\xd6\xd4\xe5\x5e\xdd\xa2\xca\xb4\x25\x52\x17\xed\x77\x8d\x1e\x0e\xb9\xee\xf3\x86\xfd\x38\x80\x94\x90\xc5\x07\x52\x35\xbd\x69\x65\xce\x84\x6e\x99\x35\x21\x48\xb7\x05\x92\xf0\x60\x98\x21\x54\x2a\x38\x9b\x9c\xa6\xd7\x37\x68\x4f\x45\x1b\x3e\x4d\x81\xba\xc7\x9f\xbe\x49\xb2\x87\x5b\xb6\xf5\xcb\x4a\x69\x27\x17\xbb\x4e\x6c\xfb\xe3\x9c\x10\xac\x39\xba\xaf\xb5\xec\xa5\x31\xab\xe7\xf3\xaa\xe7\x96\x7a\xb8\x97\xfe\xd6\x64\x97\x70\x69\xda\x8c\xba\xc3\x81\x59\xa4\xb7\xb0\xce\xe3\x2e\x78\xd0\xff\x16\x16\x00\x00\xc6\x91\x7a\xd9\xde\xe3\x3f\x3a\x18\x20\xe7\xfa\x8a\xcc\x3d\xd4\x16\x8e\xf3\xbf\x4a\xb8\x17\xa1\xdb\x53\x1f\x7f\x08\xfa\x91\x59\xfc\x19\x00\x8b\xe8\xc3\x0d\xc2\x02\x00\x00")
+
+func templatesStdoutTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesStdoutTmpl,
+		"templates/stdout.tmpl",
+	)
+}
+
+func templatesStdoutTmpl() (*asset, error) {
+	bytes, err := templatesStdoutTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/stdout.tmpl", size: 706, mode: os.FileMode(420), modTime: time.Unix(1792005031, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesTestifymsgTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xcb\x31\x0a\xc2\x40\x10\x05\xd0\xde\x53\x0c\x53\x29\x84\xbd\x87\x9d\xe0\x09\x22\x3b\x1b\x16\xdc\x49\xc8\x4c\x0a\xf9\xfc\xbb\x8b\xa9\xac\x1f\x0f\xa8\xd6\xba\x9b\x68\x5a\x64\x6f\x9f\x11\x8b\x92\x40\x6f\xb2\xee\x72\xf5\x35\xa5\x3c\x8f\xd7\x4f\xe3\x26\xe5\xb1\x77\xcf\xbb\x6f\x47\x06\x39\x89\x02\x69\x63\x7b\xcf\x69\xa2\xc3\x22\xe6\xc5\x54\x0a\xa9\x93\xfc\x53\x3f\xc7\x29\x80\x79\x25\x01\xf3\x4a\x5e\xbe\x03\x00\xc9\x93\x79\x75\x81\x00\x00\x00")
+
+func templatesTestifymsgTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTestifymsgTmpl,
+		"templates/testifymsg.tmpl",
+	)
+}
+
+func templatesTestifymsgTmpl() (*asset, error) {
+	bytes, err := templatesTestifymsgTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/testifymsg.tmpl", size: 129, mode: os.FileMode(420), modTime: time.Unix(1791998064, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesTestmainTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\xcf\x4f\x4b\xc4\x30\x10\x05\xf0\x73\xf3\x29\x1e\x7b\xb1\x15\xcc\xde\x17\xbc\xe9\x71\x59\xd0\xe2\x3d\x34\x93\x3a\xb4\x9d\x94\x26\xc1\x4a\xc8\x77\x97\xb6\xe0\x9f\x9b\xa7\x61\xe0\xbd\x1f\x33\x39\x5b\x72\x2c\x84\x53\xa4\x10\x27\xc3\x72\x2a\x45\xe5\xfc\x00\x76\xd0\xa5\x28\xe5\x92\x74\x68\x29\xc4\xab\x61\xa9\x27\xdc\x6f\x41\x96\x5e\x5f\x1b\x64\x55\xf5\x7e\x24\x33\xe8\x37\x5a\xd8\x7d\xfe\xc4\x1a\x75\x28\x34\x06\xfa\x87\x72\x3e\xa3\xbd\x3d\xdd\x2e\x78\xa5\x88\x34\x23\xbe\x13\x1c\xaf\x31\x2d\x14\xe0\xdd\xbe\xcf\xa6\x1b\x4c\x4f\x77\x01\x5b\x37\x68\x55\x75\xde\x12\x2e\x8f\x98\xf4\x4b\x92\xba\xf9\xc5\xb4\x64\x16\x58\xff\x21\x7f\x24\xad\x2a\x1f\xf4\xf3\xca\xb1\xde\xaa\xdf\x37\x8a\xdd\x7f\x3e\xe6\xd7\x00\x1c\xcf\x40\x96\x12\x01\x00\x00")
+
+func templatesTestmainTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTestmainTmpl,
+		"templates/testmain.tmpl",
+	)
+}
+
+func templatesTestmainTmpl() (*asset, error) {
+	bytes, err := templatesTestmainTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/testmain.tmpl", size: 274, mode: os.FileMode(420), modTime: time.Unix(1792023103, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesTypeargsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x79\x00\x86\xff\x7b\x7b\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x79\x70\x65\x61\x72\x67\x73\x22\x7d\x7d\x7b\x7b\x69\x66\x20\x2e\x54\x79\x70\x65\x50\x61\x72\x61\x6d\x73\x7d\x7d\x5b\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x69\x2c\x20\x24\x65\x6c\x20\x3a\x3d\x20\x2e\x54\x79\x70\x65\x50\x61\x72\x61\x6d\x73\x7d\x7d\x7b\x7b\x69\x66\x20\x24\x69\x7d\x7d\x2c\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x5d\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x03\x00\x09\xe6\x1c\x53\x79\x00\x00\x00")
+
+func templatesTypeargsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTypeargsTmpl,
+		"templates/typeargs.tmpl",
+	)
+}
+
+func templatesTypeargsTmpl() (*asset, error) {
+	bytes, err := templatesTypeargsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/typeargs.tmpl", size: 121, mode: os.FileMode(420), modTime: time.Unix(1791993554, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesUnexposableTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x4d\x6f\xdb\x30\x0c\x3d\xd7\xbf\x82\x08\x82\x2d\x19\x5a\xf5\x5e\xa0\x97\xa6\x3b\xf4\xb0\x75\xeb\x82\x0d\xd8\x65\x50\x65\xca\x16\xe2\x48\x06\x45\xb7\x0d\x08\xfd\xf7\x41\x76\x9c\xba\x4d\x30\xec\x64\x5a\xe4\x7b\xfc\x7c\x22\x25\x5a\xe7\x11\x66\x9d\xc7\x97\x36\x44\xfd\xd8\xe0\x2c\xa5\x42\xe4\x02\xe6\x16\xae\xae\x41\xa5\x54\x14\x22\xcf\x8e\x6b\xd0\xbe\x04\xb5\x0a\xed\xee\x36\x18\x50\xb7\xc1\xa4\x24\xb2\x0a\xdb\x2d\x7a\xce\x81\x22\xe8\x4b\xb8\x48\xa9\xb0\x9d\x37\x20\xa2\xd6\x18\xf9\xab\xde\x62\x4a\x0b\x86\x4f\x8c\x91\x9d\xaf\xd4\x7a\x09\x52\x9c\xe5\x1c\xce\x66\x42\x1f\x99\x3a\xc3\xee\xb1\xc1\x94\x8a\xb3\xcb\x4b\x58\xdf\xdf\xde\x5f\xc1\xaa\x46\xb3\x01\xae\x11\x08\x63\xd7\x70\x54\xb0\xae\x11\xa2\xab\xbc\xe6\x8e\x10\x6a\x1d\x61\xa8\x9c\x18\x4b\xe0\x5d\x8b\xf1\x1c\x9e\x6b\x67\x6a\xe0\xda\xc5\x9e\x0b\x5f\x18\xc9\xeb\x06\x72\x7a\x68\xb5\xd9\xe8\x0a\xc1\x68\xff\x91\xa1\x44\xd3\x68\xc2\xc1\x65\x74\xc4\x08\xc1\xaa\xa1\xb6\xbe\x65\xf5\x80\x06\xdd\x13\x52\x2e\x4c\x64\xfc\xcb\xdd\xe6\xe9\x88\xa8\xdf\x48\x61\x70\x5e\x00\xfa\x72\x34\x49\xfb\x0a\x41\x7d\xd3\xa4\xb7\xc8\x48\x31\x3b\x0e\x3d\xdf\xc5\x5f\xe4\x78\x64\xed\x83\x46\xca\x0f\x8f\x3b\xc6\xa8\x6e\x3a\x6b\x91\x64\x04\x61\x13\x71\x8f\xfc\x12\xcc\xe6\x14\x4e\x44\x65\xd7\x30\xee\x63\xe0\x61\xcc\xe1\x54\x56\x91\xb7\x01\x8b\xe5\x3b\xbc\x0f\x0c\xea\x2e\xae\x82\x67\x7c\xe1\xcc\xf0\xa4\x09\xa6\x2c\x79\xdd\xbb\x16\x0f\x7d\x4e\x86\x71\x30\x9d\x85\x40\xa0\x1e\x86\x75\x66\x83\x3b\xf2\xf1\x33\x51\x4e\x2a\x32\x4c\x6d\xee\xce\x61\x8e\x4d\xae\x6b\x0c\xcd\x4e\x67\x61\xee\x52\x3a\x87\xfe\xce\x52\xfa\xb3\xff\xf6\x9e\x23\x2a\x67\x27\xe0\x23\x0c\x5c\x8f\x2f\xa7\x97\xfd\x76\xd5\x4a\x24\x2f\x20\x3f\xcf\xad\xfa\xde\xe9\xc6\x59\x97\xc3\xd4\x9e\x44\x44\xed\xe7\x2e\x8c\xdb\xb6\xd1\x8c\x30\xcb\xe7\xa8\xa9\x8a\xb3\x4c\xb1\x38\xd5\xdc\xf4\x3a\x8e\xfb\xeb\x5f\xa6\x33\x37\x83\xa1\x6e\xb4\xd9\x54\x14\x3a\x5f\x2e\x96\xaf\x95\x1d\x36\x31\xe0\xd6\xbb\x16\xd5\x5d\xfc\xa9\xc9\xe9\xd2\x99\x94\x94\x7a\xad\x76\xfa\x59\xee\x77\xd4\xc4\x77\xea\x1b\xef\xa1\x57\xa0\xa6\xaa\xcb\x3a\x8f\x10\xec\x3f\x35\x77\xd0\x5b\x2f\xbe\x13\x9a\x23\xb4\x48\xe8\x0d\xaa\xe2\x8c\xd5\x8f\x8d\x6b\x17\xb3\x41\x8d\xe6\xff\x33\xce\xc6\xaa\x73\x07\x45\x2a\x0a\x11\xf4\x65\x4a\xc5\xdf\x01\x00\x01\x5f\xb9\x5b\xd3\x04\x00\x00")
+
+func templatesUnexposableTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesUnexposableTmpl,
+		"templates/unexposable.tmpl",
+	)
+}
+
+func templatesUnexposableTmpl() (*asset, error) {
+	bytes, err := templatesUnexposableTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/unexposable.tmpl", size: 1235, mode: os.FileMode(420), modTime: time.Unix(1792001394, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\xcd\x31\xaa\xc3\x30\x10\x84\xe1\x5e\xa7\x18\x54\xbd\x07\xc6\x3e\x41\x9a\xdc\x44\xa0\x91\x2d\x58\xa4\xa0\x5d\xc7\x85\xd0\xdd\x43\x12\x54\xa5\xfc\xa7\x98\xaf\xf7\xc8\x94\x0b\xe1\xcf\x47\x0c\x46\x3f\x86\x73\xdb\x86\x6f\xa1\xf1\x6a\xd9\xa8\xb0\x83\xd8\xab\x44\x16\xa4\x2c\x54\xd4\xf4\xd9\x8c\x6a\x8a\x2b\xdb\xf1\xce\xdc\xd0\xa8\xa7\x98\xae\xee\x19\xda\x7c\xb9\x21\x49\xd8\xd7\x7b\xad\xf2\x37\x9d\x05\x29\x88\x72\x99\xf0\x8f\xe0\xff\x5d\xef\x2c\x71\x0c\xf7\x1a\x00\xb9\xac\xd0\x06\xa5\x00\x00\x00")
+
+func templatesUpdateTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesUpdateTmpl,
+		"templates/update.tmpl",
+	)
+}
+
+func templatesUpdateTmpl() (*asset, error) {
+	bytes, err := templatesUpdateTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/update.tmpl", size: 165, mode: os.FileMode(420), modTime: time.Unix(1792000208, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -286,13 +539,26 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"templates/benchmark.tmpl": templatesBenchmarkTmpl,
 	"templates/call.tmpl": templatesCallTmpl,
+	"templates/example.tmpl": templatesExampleTmpl,
 	"templates/function.tmpl": templatesFunctionTmpl,
+	"templates/fuzz.tmpl": templatesFuzzTmpl,
+	"templates/handler.tmpl": templatesHandlerTmpl,
 	"templates/header.tmpl": templatesHeaderTmpl,
 	"templates/inline.tmpl": templatesInlineTmpl,
 	"templates/inputs.tmpl": templatesInputsTmpl,
 	"templates/message.tmpl": templatesMessageTmpl,
+	"templates/mock.tmpl": templatesMockTmpl,
+	"templates/panics.tmpl": templatesPanicsTmpl,
 	"templates/results.tmpl": templatesResultsTmpl,
+	"templates/should.tmpl": templatesShouldTmpl,
+	"templates/stdout.tmpl": templatesStdoutTmpl,
+	"templates/testifymsg.tmpl": templatesTestifymsgTmpl,
+	"templates/testmain.tmpl": templatesTestmainTmpl,
+	"templates/typeargs.tmpl": templatesTypeargsTmpl,
+	"templates/unexposable.tmpl": templatesUnexposableTmpl,
+	"templates/update.tmpl": templatesUpdateTmpl,
 }
 
 // AssetDir returns the file names below a certain
@@ -336,13 +602,26 @@ type bintree struct {
 }
 var _bintree = &bintree{nil, map[string]*bintree{
 	"templates": &bintree{nil, map[string]*bintree{
+		"benchmark.tmpl": &bintree{templatesBenchmarkTmpl, map[string]*bintree{}},
 		"call.tmpl": &bintree{templatesCallTmpl, map[string]*bintree{}},
+		"example.tmpl": &bintree{templatesExampleTmpl, map[string]*bintree{}},
 		"function.tmpl": &bintree{templatesFunctionTmpl, map[string]*bintree{}},
+		"fuzz.tmpl": &bintree{templatesFuzzTmpl, map[string]*bintree{}},
+		"handler.tmpl": &bintree{templatesHandlerTmpl, map[string]*bintree{}},
 		"header.tmpl": &bintree{templatesHeaderTmpl, map[string]*bintree{}},
 		"inline.tmpl": &bintree{templatesInlineTmpl, map[string]*bintree{}},
 		"inputs.tmpl": &bintree{templatesInputsTmpl, map[string]*bintree{}},
 		"message.tmpl": &bintree{templatesMessageTmpl, map[string]*bintree{}},
+		"mock.tmpl": &bintree{templatesMockTmpl, map[string]*bintree{}},
+		"panics.tmpl": &bintree{templatesPanicsTmpl, map[string]*bintree{}},
 		"results.tmpl": &bintree{templatesResultsTmpl, map[string]*bintree{}},
+		"should.tmpl": &bintree{templatesShouldTmpl, map[string]*bintree{}},
+		"stdout.tmpl": &bintree{templatesStdoutTmpl, map[string]*bintree{}},
+		"testifymsg.tmpl": &bintree{templatesTestifymsgTmpl, map[string]*bintree{}},
+		"testmain.tmpl": &bintree{templatesTestmainTmpl, map[string]*bintree{}},
+		"typeargs.tmpl": &bintree{templatesTypeargsTmpl, map[string]*bintree{}},
+		"unexposable.tmpl": &bintree{templatesUnexposableTmpl, map[string]*bintree{}},
+		"update.tmpl": &bintree{templatesUpdateTmpl, map[string]*bintree{}},
 	}},
 }}
 
@@ -3,9 +3,16 @@ package render
 //go:generate go-bindata -pkg=bindata -o "./bindata/bindata.go" templates
 import (
 	"fmt"
+	"go/ast"
+	"go/parser"
 	"io"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"text/template"
+	"text/template/parse"
+	"time"
 
 	"github.com/cweill/gotests/internal/models"
 	"github.com/cweill/gotests/internal/render/bindata"
@@ -15,19 +22,169 @@ const name = "name"
 
 var (
 	tmpls *template.Template
+	// The renderer of the built-in templates.
+	std *Renderer
 )
 
+// The helpers of the templates, which the funcs of New can't replace.
+var builtinFuncs = template.FuncMap{
+	"Field":    fieldName,
+	"Receiver": receiverName,
+	"Param":    parameterName,
+	"Want":     wantName,
+	"Got":      gotName,
+	"Seed":     seedValue,
+	"Zero":     zeroValue,
+	"Samples":  sampleValues,
+	"Scaffold": scaffold,
+	"Comment":  comment,
+	"Duration": durationExpr,
+}
+
 func init() {
-	tmpls = template.New("render").Funcs(map[string]interface{}{
-		"Field":    fieldName,
-		"Receiver": receiverName,
-		"Param":    parameterName,
-		"Want":     wantName,
-		"Got":      gotName,
-	})
+	tmpls = template.New("render").Funcs(builtinFuncs)
 	for _, name := range bindata.AssetNames() {
 		tmpls = template.Must(tmpls.Parse(string(bindata.MustAsset(name))))
 	}
+	std = &Renderer{tmpls: tmpls, names: defaultNames}
+}
+
+// Names are the names of the identifiers declared by the test functions.
+type Names struct {
+	// The table of test cases. Defaults to "tests".
+	CaseVar string
+	// The struct type of the arguments, and its field in the test cases.
+	// Defaults to "args".
+	ArgsStruct string
+}
+
+var defaultNames = Names{CaseVar: "tests", ArgsStruct: "args"}
+
+// A Renderer renders tests with a set of templates.
+type Renderer struct {
+	tmpls  *template.Template
+	params map[string]interface{}
+	names  Names
+}
+
+// New returns a Renderer of the built-in templates, overridden by the
+// templates defined in the .tmpl files in dir. The built-in templates are
+// used as is if dir is empty. The params are available to all templates as
+// .TemplateParams, where the keys that aren't set render as empty. The funcs
+// are added to the helpers of the templates, and must not be named after the
+// built-in ones. The names that aren't set default to those of defaultNames.
+func New(dir string, params map[string]interface{}, funcs template.FuncMap, names Names) (*Renderer, error) {
+	if names.CaseVar == "" {
+		names.CaseVar = defaultNames.CaseVar
+	}
+	if names.ArgsStruct == "" {
+		names.ArgsStruct = defaultNames.ArgsStruct
+	}
+	if dir == "" && params == nil && funcs == nil && names == defaultNames {
+		return std, nil
+	}
+	t, err := parseDir(dir, funcs)
+	if err != nil {
+		return nil, err
+	}
+	return &Renderer{tmpls: t, params: templateParams(t, params), names: names}, nil
+}
+
+// parseDir returns the built-in templates, overridden by the ones in dir,
+// which may use funcs.
+func parseDir(dir string, funcs template.FuncMap) (*template.Template, error) {
+	for name := range funcs {
+		if builtinFuncs[name] != nil {
+			return nil, fmt.Errorf("template func %v: a built-in helper has the name", name)
+		}
+	}
+	if dir == "" && funcs == nil {
+		return tmpls, nil
+	}
+	var files []string
+	if dir != "" {
+		if _, err := os.Stat(dir); err != nil {
+			return nil, fmt.Errorf("template directory: %v", err)
+		}
+		var err error
+		if files, err = filepath.Glob(filepath.Join(dir, "*.tmpl")); err != nil {
+			return nil, fmt.Errorf("filepath.Glob: %v", err)
+		}
+	}
+	t, err := tmpls.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("template.Clone: %v", err)
+	}
+	t.Funcs(funcs)
+	if len(files) == 0 {
+		return t, nil
+	}
+	if t, err = t.ParseFiles(files...); err != nil {
+		return nil, fmt.Errorf("parsing custom templates: %v", err)
+	}
+	return t, nil
+}
+
+// templateParams returns a copy of params that also has an empty string for
+// every key the templates of t reference as .TemplateParams.<key> but params
+// doesn't set, since those would otherwise render as "<no value>".
+func templateParams(t *template.Template, params map[string]interface{}) map[string]interface{} {
+	ps := make(map[string]interface{}, len(params))
+	for k, v := range params {
+		ps[k] = v
+	}
+	ref := func(ident []string) {
+		if len(ident) > 1 && ident[0] == "TemplateParams" && ps[ident[1]] == nil {
+			ps[ident[1]] = ""
+		}
+	}
+	var walk func(n parse.Node)
+	walk = func(n parse.Node) {
+		switch n := n.(type) {
+		case *parse.ListNode:
+			if n != nil {
+				for _, c := range n.Nodes {
+					walk(c)
+				}
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.PipeNode:
+			if n != nil {
+				for _, c := range n.Cmds {
+					walk(c)
+				}
+			}
+		case *parse.CommandNode:
+			for _, a := range n.Args {
+				walk(a)
+			}
+		case *parse.ChainNode:
+			walk(n.Node)
+		case *parse.FieldNode:
+			ref(n.Ident)
+		case *parse.VariableNode:
+			ref(n.Ident[1:])
+		case *parse.IfNode:
+			walk(&n.BranchNode)
+		case *parse.RangeNode:
+			walk(&n.BranchNode)
+		case *parse.WithNode:
+			walk(&n.BranchNode)
+		case *parse.BranchNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.TemplateNode:
+			walk(n.Pipe)
+		}
+	}
+	for _, tmpl := range t.Templates() {
+		if tmpl.Tree != nil {
+			walk(tmpl.Tree.Root)
+		}
+	}
+	return ps
 }
 
 func fieldName(f *models.Field) string {
@@ -66,7 +223,9 @@ func parameterName(f *models.Field) string {
 
 func wantName(f *models.Field) string {
 	var n string
-	if f.IsNamed() {
+	if f.IsOk {
+		n = "wantOk"
+	} else if f.IsNamed() {
 		n = "want" + strings.Title(f.Name)
 	} else if f.Index == 0 {
 		n = "want"
@@ -78,7 +237,9 @@ func wantName(f *models.Field) string {
 
 func gotName(f *models.Field) string {
 	var n string
-	if f.IsNamed() {
+	if f.IsOk {
+		n = "gotOk"
+	} else if f.IsNamed() {
 		n = "got" + strings.Title(f.Name)
 	} else if f.Index == 0 {
 		n = "got"
@@ -88,24 +249,522 @@ func gotName(f *models.Field) string {
 	return n
 }
 
-func Header(w io.Writer, h *models.Header) error {
-	if err := tmpls.ExecuteTemplate(w, "header", h); err != nil {
+func seedValue(f *models.Field) string {
+	switch t := f.Type.String(); t {
+	case "string":
+		return `""`
+	case "[]byte":
+		return `[]byte("")`
+	case "bool":
+		return "false"
+	case "int":
+		return "0"
+	default:
+		return t + "(0)"
+	}
+}
+
+func (r *Renderer) Header(w io.Writer, h *models.Header) error {
+	if err := r.tmpls.ExecuteTemplate(w, "header", struct {
+		*models.Header
+		TemplateParams map[string]interface{}
+	}{
+		Header:         h,
+		TemplateParams: r.params,
+	}); err != nil {
 		return err
 	}
 	_, err := w.Write(h.Code)
 	return err
 }
 
-func TestFunction(w io.Writer, f *models.Function, printInputs bool, subtests bool, allowError bool) error {
-	return tmpls.ExecuteTemplate(w, "function", struct {
+// BenchmarkFunction renders the benchmark of f. Given sizes, it runs a
+// sub-benchmark for each of them, passing an int or slice of that size as the
+// SizeParameter of f, if it has one.
+func (r *Renderer) BenchmarkFunction(w io.Writer, f *models.Function, sizes []int) error {
+	return r.tmpls.ExecuteTemplate(w, "benchmark", struct {
+		*models.Function
+		Constructor    *models.Function // Only set for the tests.
+		BenchSizes     []int
+		CaseVarName    string
+		ArgsStructName string
+		TemplateParams map[string]interface{}
+	}{
+		Function:       f,
+		BenchSizes:     sizes,
+		CaseVarName:    r.names.CaseVar,
+		ArgsStructName: r.names.ArgsStruct,
+		TemplateParams: r.params,
+	})
+}
+
+func (r *Renderer) ExampleFunction(w io.Writer, f *models.Function) error {
+	return r.tmpls.ExecuteTemplate(w, "example", r.function(f))
+}
+
+// HandlerFunction renders the test of the HTTP handler f, which calls it with
+// an httptest request and recorder.
+func (r *Renderer) HandlerFunction(w io.Writer, f *models.Function, subtests bool, allowError bool, copyDoc bool) error {
+	return r.tmpls.ExecuteTemplate(w, "handler", struct {
+		*models.Function
+		Subtests       bool
+		AllowError     bool
+		CopyDoc        bool
+		CaseVarName    string
+		TemplateParams map[string]interface{}
+	}{
+		Function:       f,
+		Subtests:       subtests,
+		AllowError:     allowError,
+		CopyDoc:        copyDoc,
+		CaseVarName:    r.names.CaseVar,
+		TemplateParams: r.params,
+	})
+}
+
+// UnexposableFunction renders the test of f in an external test package,
+// which can't reference the unexported types in its signature. The test calls
+// f with the arguments it can construct, or is skipped if it can't.
+func (r *Renderer) UnexposableFunction(w io.Writer, f *models.Function, copyDoc bool) error {
+	return r.tmpls.ExecuteTemplate(w, "unexposable", struct {
+		*models.Function
+		CopyDoc        bool
+		TemplateParams map[string]interface{}
+	}{
+		Function:       f,
+		CopyDoc:        copyDoc,
+		TemplateParams: r.params,
+	})
+}
+
+func (r *Renderer) FuzzFunction(w io.Writer, f *models.Function) error {
+	return r.tmpls.ExecuteTemplate(w, "fuzz", r.function(f))
+}
+
+// function returns the data of the templates that render f.
+func (r *Renderer) function(f *models.Function) interface{} {
+	return struct {
+		*models.Function
+		Constructor    *models.Function // Only set for the tests.
+		CaseVarName    string
+		ArgsStructName string
+		TemplateParams map[string]interface{}
+	}{
+		Function:       f,
+		CaseVarName:    r.names.CaseVar,
+		ArgsStructName: r.names.ArgsStruct,
+		TemplateParams: r.params,
+	}
+}
+
+func (r *Renderer) Mock(w io.Writer, f *models.Field) error {
+	return r.tmpls.ExecuteTemplate(w, "mock", f)
+}
+
+// caseNames returns the names of n blank test cases: case1, case2, and so on.
+func caseNames(n int) []string {
+	var names []string
+	for i := 1; i <= n; i++ {
+		names = append(names, fmt.Sprintf("case%d", i))
+	}
+	return names
+}
+
+// The most test cases seeded with the boundary values of the numeric
+// parameters of a function.
+const maxBoundaryCases = 8
+
+// A boundaryCase is a test case seeded with boundary values of the numeric
+// parameters of a function.
+type boundaryCase struct {
+	Name string
+	Args []boundaryArg
+}
+
+// A boundaryArg is the boundary Value of a numeric parameter, labeled for
+// the name of its test case.
+type boundaryArg struct {
+	Field *models.Field
+	Value string
+	label string
+}
+
+// boundaryCases returns the test cases of f seeded with the boundary values
+// of its numeric parameters: first those taking each boundary value of every
+// parameter, then the others of their cross-product, up to maxBoundaryCases.
+func boundaryCases(f *models.Function) []boundaryCase {
+	var params [][]boundaryArg
+	for _, p := range f.TestParameters() {
+		if vs := boundaryValues(p); vs != nil {
+			params = append(params, vs)
+		}
+	}
+	if params == nil {
+		return nil
+	}
+	var cases []boundaryCase
+	seen := make(map[string]bool)
+	add := func(row []int) {
+		c := boundaryCase{}
+		var labels []string
+		for i, j := range row {
+			a := params[i][j]
+			c.Args = append(c.Args, a)
+			labels = append(labels, parameterName(a.Field)+"="+a.label)
+		}
+		c.Name = strings.Join(labels, ", ")
+		if !seen[c.Name] && len(cases) < maxBoundaryCases {
+			seen[c.Name] = true
+			cases = append(cases, c)
+		}
+	}
+	var n int
+	for _, vs := range params {
+		if len(vs) > n {
+			n = len(vs)
+		}
+	}
+	row := make([]int, len(params))
+	for i := 0; i < n; i++ {
+		for j, vs := range params {
+			row[j] = i % len(vs)
+		}
+		add(row)
+	}
+	// The cross-product is enumerated with the last parameter varying the
+	// fastest.
+	row = make([]int, len(params))
+	for len(cases) < maxBoundaryCases {
+		add(row)
+		i := len(row) - 1
+		for ; i >= 0; i-- {
+			if row[i]++; row[i] < len(params[i]) {
+				break
+			}
+			row[i] = 0
+		}
+		if i < 0 {
+			break
+		}
+	}
+	return cases
+}
+
+// boundaryValues returns the boundary values of f if it's of a NumberType:
+// 0, its minimum and maximum, -1, and 1, or else nil.
+func boundaryValues(f *models.Field) []boundaryArg {
+	var lo, hi string
+	switch u := f.NumberType(); u {
+	case "int", "int8", "int16", "int32", "int64":
+		bits := strings.TrimPrefix(u, "int")
+		lo, hi = "math.MinInt"+bits, "math.MaxInt"+bits
+	case "rune":
+		lo, hi = "math.MinInt32", "math.MaxInt32"
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		hi = "math.MaxUint" + strings.TrimPrefix(u, "uint")
+	case "byte":
+		hi = "math.MaxUint8"
+	case "float32", "float64":
+		hi = "math.MaxFloat" + strings.TrimPrefix(u, "float")
+		lo = "-" + hi
+	default:
+		return nil
+	}
+	// Unsigned types have 0 for their minimum, and no -1.
+	vs := []boundaryArg{{f, "0", "0"}}
+	if lo != "" {
+		vs = append(vs, boundaryArg{f, lo, "min"})
+	}
+	vs = append(vs, boundaryArg{f, hi, "max"})
+	if lo != "" {
+		vs = append(vs, boundaryArg{f, "-1", "-1"})
+	}
+	return append(vs, boundaryArg{f, "1", "1"})
+}
+
+// UpdateFlag renders the declaration of the -update flag of the tests
+// comparing results against golden files.
+func (r *Renderer) UpdateFlag(w io.Writer) error {
+	return r.tmpls.ExecuteTemplate(w, "update", nil)
+}
+
+// TestMain renders a TestMain function with the setup and teardown of the
+// package's tests left to do or, if leakCheck is set, one verifying with
+// goleak that they leave no goroutines running.
+func (r *Renderer) TestMain(w io.Writer, leakCheck bool) error {
+	return r.tmpls.ExecuteTemplate(w, "testmain", leakCheck)
+}
+
+// TestOptions are the options of the tests rendered by TestFunction.
+type TestOptions struct {
+	PrintInputs bool
+	Subtests    bool
+	AllowError  bool
+	CmpDiff     bool
+	Parallel    bool // Only used with Subtests.
+	Cleanup     bool
+	Helpers     bool
+	// How errors are compared: "bool" (the default), "is", or "message".
+	ErrorComparison string
+	CopyDoc         bool
+	Assertion       string // "should" (the default) or "testify".
+	VariadicCases   bool
+	ScaffoldArgs    bool
+	Panics          bool
+	TableStyle      string // "slice" (the default) or "map".
+	Golden          bool
+	MessageFormat   string // "v" (the default), "+v", or "#v".
+	EnvSetup        bool
+	SortSlices      bool
+	CaseTimeout     time.Duration
+	NumberCases     bool
+	DerefPointers   bool
+	CaptureStdout   bool // Only used for the functions printing to stdout.
+	Cases           int
+	AsyncPattern    bool
+	BoundaryCases   bool
+	UseConstructors bool
+	LeakCheck       bool
+	UseEqualMethod  bool
+	CoverageHints   bool
+	LintFriendly    bool
+	SkipEmpty       bool
+	ConcurrencyCase bool
+	AutoName        bool // Only used for the functions with parameters.
+	FieldDiff       bool
+	GoVersion       string
+	TempDirForPaths bool
+	SeedErrorCase   bool // Only used for the functions returning an error.
+}
+
+func (r *Renderer) TestFunction(w io.Writer, f *models.Function, opt *TestOptions) error {
+	o := *opt
+	if o.MessageFormat == "" {
+		o.MessageFormat = "v"
+	}
+	if o.TableStyle == "" {
+		o.TableStyle = "slice"
+	}
+	if o.ErrorComparison == "" {
+		o.ErrorComparison = "bool"
+	}
+	if o.Assertion == "" {
+		o.Assertion = "should"
+	}
+	var boundaries []boundaryCase
+	if o.BoundaryCases {
+		boundaries = boundaryCases(f)
+	}
+	var ctor *models.Function
+	hasInputs := f.HasInputs()
+	if r := f.Receiver; o.UseConstructors && r != nil && r.Constructor != nil {
+		// The receiver is built from the constructor's arguments alone.
+		ctor = r.Constructor
+		hasInputs = len(f.TestParameters()) > 0 || len(ctor.Parameters) > 0
+	}
+	// The fields below take precedence over those of the options.
+	return r.tmpls.ExecuteTemplate(w, "function", struct {
 		*models.Function
-		PrintInputs bool
-		Subtests    bool
-		AllowError  bool
+		TestOptions
+		Parallel       bool
+		CaptureStdout  bool
+		CaseNames      []string
+		BoundaryCases  []boundaryCase
+		Constructor    *models.Function
+		AutoName       bool
+		CopyLoopVar    bool
+		SeedErrorCase  bool
+		HasInputs      bool
+		CaseVarName    string
+		ArgsStructName string
+		TemplateParams map[string]interface{}
 	}{
-		Function:    f,
-		PrintInputs: printInputs,
-		Subtests:    subtests,
-		AllowError:  allowError,
+		Function:       f,
+		TestOptions:    o,
+		Parallel:       o.Parallel && o.Subtests,
+		CaptureStdout:  o.CaptureStdout && f.PrintsStdout,
+		CaseNames:      caseNames(o.Cases),
+		BoundaryCases:  boundaries,
+		Constructor:    ctor,
+		AutoName:       o.AutoName && len(f.TestParameters()) > 0,
+		CopyLoopVar:    !loopVarPerIteration(o.GoVersion),
+		SeedErrorCase:  o.SeedErrorCase && f.ReturnsError,
+		HasInputs:      hasInputs,
+		CaseVarName:    r.names.CaseVar,
+		ArgsStructName: r.names.ArgsStruct,
+		TemplateParams: r.params,
 	})
 }
+
+// loopVarPerIteration reports whether the for loops of the Go version v,
+// such as 1.22 or go1.22.1, declare their variables per iteration, as they
+// do since Go 1.22, so that the closures of subtests can capture them. The
+// versions that don't parse, such as devel ones, are taken to be recent.
+func loopVarPerIteration(v string) bool {
+	v = strings.TrimPrefix(v, "go")
+	major, rest, _ := strings.Cut(v, ".")
+	minor, _, _ := strings.Cut(rest, ".")
+	x, err := strconv.Atoi(major)
+	if err != nil {
+		return true
+	}
+	y, err := strconv.Atoi(minor)
+	if err != nil {
+		return true
+	}
+	return x > 1 || x == 1 && y >= 22
+}
+
+// zeroValue returns an expression of the zero value of f's type.
+func zeroValue(f *models.Field) string {
+	if f.Type.IsStar {
+		return "nil"
+	}
+	u := f.Type.Underlying
+	if u == "" {
+		u = f.Type.Value
+	}
+	switch u {
+	case "string":
+		return `""`
+	case "bool":
+		return "false"
+	case "error", "any":
+		return "nil"
+	}
+	if isNumber(u) {
+		return "0"
+	}
+	for _, p := range []string{"[]", "map[", "chan ", "<-chan ", "func(", "interface", "*"} {
+		if strings.HasPrefix(u, p) {
+			return "nil"
+		}
+	}
+	if strings.HasPrefix(u, "struct") || strings.HasPrefix(u, "[") {
+		return f.Type.Value + "{}"
+	}
+	return "*new(" + f.Type.Value + ")"
+}
+
+// scaffold returns an expression constructing a value of f's type if it's a
+// channel, as a bidirectional one, or a function, as a stub returning zero
+// values. It returns "" for other types.
+func scaffold(f *models.Field) string {
+	if f.Type.IsStar || f.Type.IsVariadic {
+		return ""
+	}
+	e, err := parser.ParseExpr(f.Type.Value)
+	if err != nil {
+		return ""
+	}
+	switch t := e.(type) {
+	case *ast.ChanType:
+		return "make(chan " + f.Type.Value[t.Value.Pos()-1:] + ")"
+	case *ast.FuncType:
+		if t.Results == nil {
+			return f.Type.Value + " {}"
+		}
+		var zs []string
+		for _, r := range t.Results.List {
+			z := zeroValue(&models.Field{Type: &models.Expression{Value: f.Type.Value[r.Type.Pos()-1 : r.Type.End()-1]}})
+			n := len(r.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				zs = append(zs, z)
+			}
+		}
+		return f.Type.Value + " { return " + strings.Join(zs, ", ") + " }"
+	}
+	return ""
+}
+
+// sampleValues returns two expressions of values of f's type, or of its
+// elements if it's variadic. They're distinct for numbers, strings, and
+// booleans, and zero values otherwise.
+func sampleValues(f *models.Field) []string {
+	u := f.Type.Underlying
+	if u == "" {
+		u = f.Type.Value
+	}
+	switch {
+	case f.Type.IsStar:
+	case u == "string":
+		return []string{`"a"`, `"b"`}
+	case u == "bool":
+		return []string{"true", "false"}
+	case isNumber(u):
+		return []string{"1", "2"}
+	}
+	z := zeroValue(f)
+	return []string{z, z}
+}
+
+func isNumber(t string) bool {
+	switch t {
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+		"byte", "rune", "float32", "float64", "complex64", "complex128":
+		return true
+	}
+	return false
+}
+
+// durationExpr returns an expression of d as a multiple of the largest unit
+// of the time package that divides it, such as 1500*time.Millisecond.
+func durationExpr(d time.Duration) string {
+	for _, u := range []struct {
+		d    time.Duration
+		name string
+	}{
+		{time.Hour, "Hour"},
+		{time.Minute, "Minute"},
+		{time.Second, "Second"},
+		{time.Millisecond, "Millisecond"},
+		{time.Microsecond, "Microsecond"},
+	} {
+		if d%u.d == 0 {
+			return fmt.Sprintf("%v*time.%v", int64(d/u.d), u.name)
+		}
+	}
+	return fmt.Sprintf("time.Duration(%v)", int64(d))
+}
+
+// The column to reflow copied doc comments at.
+const commentWidth = 77
+
+// comment returns the doc text as a line comment, with its paragraphs
+// reflowed. Indented lines, such as code blocks, are kept as is.
+func comment(doc string) string {
+	b := &strings.Builder{}
+	var words []string
+	flush := func() {
+		line := "//"
+		for _, w := range words {
+			if len(line) > 2 && len(line)+1+len(w) > commentWidth {
+				b.WriteString(line + "\n")
+				line = "//"
+			}
+			line += " " + w
+		}
+		if len(words) > 0 {
+			b.WriteString(line + "\n")
+		}
+		words = nil
+	}
+	for _, l := range strings.Split(strings.TrimRight(doc, "\n"), "\n") {
+		switch {
+		case strings.TrimSpace(l) == "":
+			flush()
+			b.WriteString("//\n")
+		case l[0] == ' ' || l[0] == '\t':
+			flush()
+			b.WriteString("//" + l + "\n")
+		default:
+			words = append(words, strings.Fields(l)...)
+		}
+	}
+	flush()
+	return b.String()
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"github.com/cweill/gotests/testdata/aliases/shared/config"
+	"github.com/cweill/gotests/testdata/aliases/shared/errors"
+)
+
+type Settings struct {
+	Name    string
+	Options config.Options
+}
+
+func Load(name string, opts config.Options) (*Settings, error) {
+	if name == "" {
+		return nil, &errors.Error{Code: 1}
+	}
+	return &Settings{Name: name, Options: opts}, nil
+}
+
+func Check(s *Settings) *errors.Error {
+	if s.Name == "" {
+		return &errors.Error{Code: 2}
+	}
+	return nil
+}
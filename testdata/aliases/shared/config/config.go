@@ -0,0 +1,5 @@
+package config
+
+type Options struct {
+	Verbose bool
+}
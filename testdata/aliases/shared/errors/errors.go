@@ -0,0 +1,9 @@
+package errors
+
+type Error struct {
+	Code int
+}
+
+func (e *Error) Error() string {
+	return "error"
+}
@@ -0,0 +1,7 @@
+//go:build !plan9
+
+package constraints
+
+type Info int
+
+func (i Info) Describe() string { return "" }
@@ -0,0 +1,11 @@
+// Copyright notice.
+
+//go:build plan9 && !purego
+
+package constraints
+
+type Info struct {
+	Name string
+}
+
+func (i Info) Describe() string { return i.Name }
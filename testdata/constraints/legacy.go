@@ -0,0 +1,5 @@
+// +build linux,amd64
+
+package constraints
+
+func Legacy() bool { return true }
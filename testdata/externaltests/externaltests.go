@@ -0,0 +1,7 @@
+package externaltests
+
+import "strings"
+
+func Shout(s string) string { return strings.ToUpper(s) + "!" }
+
+func Whisper(s string) string { return strings.ToLower(s) + "..." }
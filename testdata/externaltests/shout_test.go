@@ -0,0 +1,13 @@
+package externaltests_test
+
+import (
+	"testing"
+
+	"github.com/cweill/gotests/testdata/externaltests"
+)
+
+func TestShout(t *testing.T) {
+	if got := externaltests.Shout("hi"); got != "HI!" {
+		t.Errorf("Shout() = %v, want HI!", got)
+	}
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cweill/gotests/testdata/aliases/shared/config"
+	errors1 "github.com/cweill/gotests/testdata/aliases/shared/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		name string
+		opts config.Options
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *Settings
+		wantErr error
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Load(tt.args.name, tt.args.opts)
+
+		should.True(errors.Is(err, tt.wantErr),
+			fmt.Sprintf("%q. Load() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Load() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestCheck(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s *Settings
+	}
+	tests := []struct {
+		name string
+		args args
+		want *errors1.Error
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Check(tt.args.s)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Check() = %v, want %v", tt.name, got, tt.want))
+	}
+}
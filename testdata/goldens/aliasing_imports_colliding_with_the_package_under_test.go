@@ -0,0 +1,55 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cweill/gotests/testdata/aliases/config"
+	config1 "github.com/cweill/gotests/testdata/aliases/shared/config"
+	"github.com/cweill/gotests/testdata/aliases/shared/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		name string
+		opts config1.Options
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *config.Settings
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := config.Load(tt.args.name, tt.args.opts)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Load() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Load() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestCheck(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s *config.Settings
+	}
+	tests := []struct {
+		name string
+		args args
+		want *errors.Error
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := config.Check(tt.args.s)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Check() = %v, want %v", tt.name, got, tt.want))
+	}
+}
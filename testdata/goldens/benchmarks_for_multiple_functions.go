@@ -0,0 +1,115 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFooFilter(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		strs []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*Bar
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := FooFilter(tt.args.strs)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. FooFilter() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. FooFilter() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func BenchmarkFooFilter(b *testing.B) {
+	type args struct {
+		strs []string
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		_, _ = FooFilter(tt.args.strs)
+	}
+}
+
+func TestBar_BarFilter(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		i interface{}
+	}
+	tests := []struct {
+		name    string
+		b       *Bar
+		args    args
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		b := &Bar{}
+		err := b.BarFilter(tt.args.i)
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Bar.BarFilter() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+	}
+}
+
+func BenchmarkBar_BarFilter(b *testing.B) {
+	type args struct {
+		i interface{}
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		b := &Bar{}
+		_ = b.BarFilter(tt.args.i)
+	}
+}
+
+func Test_bazFilter(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		f *float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := bazFilter(tt.args.f)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. bazFilter() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func Benchmark_bazFilter(b *testing.B) {
+	type args struct {
+		f *float64
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		_ = bazFilter(tt.args.f)
+	}
+}
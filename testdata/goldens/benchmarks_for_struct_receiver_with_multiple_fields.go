@@ -0,0 +1,71 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerson_SayHello(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		FirstName string
+		LastName  string
+		Age       int
+		Gender    string
+		Siblings  []*Person
+	}
+	type args struct {
+		r *Person
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		p := &Person{
+			FirstName: tt.fields.FirstName,
+			LastName:  tt.fields.LastName,
+			Age:       tt.fields.Age,
+			Gender:    tt.fields.Gender,
+			Siblings:  tt.fields.Siblings,
+		}
+		got := p.SayHello(tt.args.r)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Person.SayHello() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func BenchmarkPerson_SayHello(b *testing.B) {
+	type fields struct {
+		FirstName string
+		LastName  string
+		Age       int
+		Gender    string
+		Siblings  []*Person
+	}
+	type args struct {
+		r *Person
+	}
+	tt := struct {
+		fields fields
+		args   args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		p := &Person{
+			FirstName: tt.fields.FirstName,
+			LastName:  tt.fields.LastName,
+			Age:       tt.fields.Age,
+			Gender:    tt.fields.Gender,
+			Siblings:  tt.fields.Siblings,
+		}
+		_ = p.SayHello(tt.args.r)
+	}
+}
@@ -0,0 +1,31 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitials71(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "case1"},
+		{name: "case2"},
+		{name: "case3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Initials71(tt.args.name)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Initials71() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitials71(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		name string
+	}
+	tests := map[string]struct {
+		args args
+		want string
+	}{
+		"case1": {},
+		"case2": {},
+		"case3": {},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := Initials71(tt.args.name)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Initials71() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup77(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		m   map[string]int
+		key string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantValue int
+		wantOk    bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotValue, gotOk := Lookup77(tt.args.m, tt.args.key)
+
+			should.Equal(gotValue, tt.wantValue,
+				fmt.Sprintf("Lookup77() gotValue = %v, wantValue %v", gotValue, tt.wantValue))
+
+			should.Equal(gotOk, tt.wantOk,
+				fmt.Sprintf("Lookup77() ok = %v, wantOk %v", gotOk, tt.wantOk))
+		})
+	}
+}
+
+func TestValid77(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotOk := Valid77(tt.args.s)
+			should.Equal(gotOk, tt.wantOk,
+				fmt.Sprintf("Valid77() ok = %v, wantOk %v", gotOk, tt.wantOk))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package testdata
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLookup77(t *testing.T) {
+	type args struct {
+		m   map[string]int
+		key string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantValue int
+		wantOk    bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		gotValue, gotOk := Lookup77(tt.args.m, tt.args.key)
+
+		if diff := cmp.Diff(tt.wantValue, gotValue); diff != "" {
+			t.Errorf("%q. Lookup77() gotValue mismatch (-want +got):\n%s", tt.name, diff)
+		}
+
+		if gotOk != tt.wantOk {
+			t.Errorf("%q. Lookup77() ok = %v, wantOk %v", tt.name, gotOk, tt.wantOk)
+		}
+	}
+}
+
+func TestValid77(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		gotOk := Valid77(tt.args.s)
+		if gotOk != tt.wantOk {
+			t.Errorf("%q. Valid77() ok = %v, wantOk %v", tt.name, gotOk, tt.wantOk)
+		}
+	}
+}
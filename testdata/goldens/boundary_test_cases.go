@@ -0,0 +1,117 @@
+package testdata
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdd75(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "a=0, b=0",
+			args: args{a: 0, b: 0},
+		},
+		{
+			name: "a=min, b=min",
+			args: args{a: math.MinInt, b: math.MinInt},
+		},
+		{
+			name: "a=max, b=max",
+			args: args{a: math.MaxInt, b: math.MaxInt},
+		},
+		{
+			name: "a=-1, b=-1",
+			args: args{a: -1, b: -1},
+		},
+		{
+			name: "a=1, b=1",
+			args: args{a: 1, b: 1},
+		},
+		{
+			name: "a=0, b=min",
+			args: args{a: 0, b: math.MinInt},
+		},
+		{
+			name: "a=0, b=max",
+			args: args{a: 0, b: math.MaxInt},
+		},
+		{
+			name: "a=0, b=-1",
+			args: args{a: 0, b: -1},
+		},
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Add75(tt.args.a, tt.args.b)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Add75() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestScale75(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		x float64
+		n uint8
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "x=0, n=0",
+			args: args{x: 0, n: 0},
+		},
+		{
+			name: "x=min, n=max",
+			args: args{x: -math.MaxFloat64, n: math.MaxUint8},
+		},
+		{
+			name: "x=max, n=1",
+			args: args{x: math.MaxFloat64, n: 1},
+		},
+		{
+			name: "x=-1, n=0",
+			args: args{x: -1, n: 0},
+		},
+		{
+			name: "x=1, n=max",
+			args: args{x: 1, n: math.MaxUint8},
+		},
+		{
+			name: "x=0, n=max",
+			args: args{x: 0, n: math.MaxUint8},
+		},
+		{
+			name: "x=0, n=1",
+			args: args{x: 0, n: 1},
+		},
+		{
+			name: "x=min, n=0",
+			args: args{x: -math.MaxFloat64, n: 0},
+		},
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Scale75(tt.args.x, tt.args.n)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Scale75() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package testdata
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScale75(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		x float64
+		n uint8
+	}
+	tests := map[string]struct {
+		args args
+		want float64
+	}{
+		"x=0, n=0": {
+			args: args{x: 0, n: 0},
+		},
+		"x=min, n=max": {
+			args: args{x: -math.MaxFloat64, n: math.MaxUint8},
+		},
+		"x=max, n=1": {
+			args: args{x: math.MaxFloat64, n: 1},
+		},
+		"x=-1, n=0": {
+			args: args{x: -1, n: 0},
+		},
+		"x=1, n=max": {
+			args: args{x: 1, n: math.MaxUint8},
+		},
+		"x=0, n=max": {
+			args: args{x: 0, n: math.MaxUint8},
+		},
+		"x=0, n=1": {
+			args: args{x: 0, n: 1},
+		},
+		"x=min, n=0": {
+			args: args{x: -math.MaxFloat64, n: 0},
+		},
+		// TODO: Add test cases.
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := Scale75(tt.args.x, tt.args.n)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Scale75() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+//go:build plan9 && !purego
+
+// Copyright notice.
+
+package constraints
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInfo_Describe(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		Name string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		i := Info{
+			Name: tt.fields.Name,
+		}
+		got := i.Describe()
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Info.Describe() = %v, want %v", tt.name, got, tt.want))
+	}
+}
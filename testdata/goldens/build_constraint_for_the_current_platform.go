@@ -0,0 +1,26 @@
+//go:build !plan9
+
+package constraints
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInfo_Describe(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name string
+		i    Info
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := tt.i.Describe()
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Info.Describe() = %v, want %v", tt.name, got, tt.want))
+	}
+}
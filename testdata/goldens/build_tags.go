@@ -0,0 +1,30 @@
+//go:build integration && e2e
+// +build integration,e2e
+
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitials71(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Initials71(tt.args.name)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Initials71() = %v, want %v", tt.name, got, tt.want))
+	}
+}
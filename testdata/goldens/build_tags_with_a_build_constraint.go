@@ -0,0 +1,26 @@
+//go:build linux && amd64 && integration
+// +build linux,amd64,integration
+
+package constraints
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLegacy(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name string
+		want bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Legacy()
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Legacy() = %v, want %v", tt.name, got, tt.want))
+	}
+}
@@ -0,0 +1,130 @@
+package testdata
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGreet70(t *testing.T) {
+	should := require.New(t)
+	captureStdout := func(t *testing.T) func() string {
+		t.Helper()
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		stdout := os.Stdout
+		os.Stdout = w
+		output := make(chan string)
+		go func() {
+			var b bytes.Buffer
+			io.Copy(&b, r)
+			output <- b.String()
+		}()
+		return func() string {
+			os.Stdout = stdout
+			w.Close()
+			return <-output
+		}
+	}
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantOutput string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origStdout := os.Stdout
+			defer func() { os.Stdout = origStdout }()
+			readStdout := captureStdout(t)
+			Greet70(tt.args.name)
+			gotOutput := readStdout()
+			should.Equal(gotOutput, tt.wantOutput,
+				fmt.Sprintf("Greet70() output = %v, want %v", gotOutput, tt.wantOutput))
+		})
+	}
+}
+
+func TestSum70(t *testing.T) {
+	should := require.New(t)
+	captureStdout := func(t *testing.T) func() string {
+		t.Helper()
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		stdout := os.Stdout
+		os.Stdout = w
+		output := make(chan string)
+		go func() {
+			var b bytes.Buffer
+			io.Copy(&b, r)
+			output <- b.String()
+		}()
+		return func() string {
+			os.Stdout = stdout
+			w.Close()
+			return <-output
+		}
+	}
+	type args struct {
+		ns []int
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       int
+		wantErr    bool
+		wantOutput string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origStdout := os.Stdout
+			defer func() { os.Stdout = origStdout }()
+			readStdout := captureStdout(t)
+			got, err := Sum70(tt.args.ns...)
+			gotOutput := readStdout()
+			should.Equal(gotOutput, tt.wantOutput,
+				fmt.Sprintf("Sum70() output = %v, want %v", gotOutput, tt.wantOutput))
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Sum70() error = %v, wantErr %v", err, tt.wantErr))
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Sum70() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestDouble70(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Double70(tt.args.n)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Double70() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package testdata
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGreet70(t *testing.T) {
+	captureStdout := func(t *testing.T) func() string {
+		t.Helper()
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		stdout := os.Stdout
+		os.Stdout = w
+		output := make(chan string)
+		go func() {
+			var b bytes.Buffer
+			io.Copy(&b, r)
+			output <- b.String()
+		}()
+		return func() string {
+			os.Stdout = stdout
+			w.Close()
+			return <-output
+		}
+	}
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantOutput string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		origStdout := os.Stdout
+		defer func() { os.Stdout = origStdout }()
+		readStdout := captureStdout(t)
+		Greet70(tt.args.name)
+		gotOutput := readStdout()
+		if diff := cmp.Diff(tt.wantOutput, gotOutput); diff != "" {
+			t.Errorf("%q. Greet70() output mismatch (-want +got):\n%s", tt.name, diff)
+		}
+	}
+}
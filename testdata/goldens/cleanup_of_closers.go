@@ -0,0 +1,103 @@
+package testdata
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen47(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *os.File
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Open47(tt.args.name)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Open47() error = %v, wantErr %v", err, tt.wantErr))
+
+			t.Cleanup(func() { got.Close() })
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Open47() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestConn47_Close(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		addr string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Conn47{
+				addr: tt.fields.addr,
+			}
+			err := c.Close()
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Conn47.Close() error = %v, wantErr %v", err, tt.wantErr))
+		})
+	}
+}
+
+func TestDial47(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		addr string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Conn47
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Dial47(tt.args.addr)
+			t.Cleanup(func() { got.Close() })
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Dial47() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestAddr47(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		c *Conn47
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Addr47(tt.args.c)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Addr47() = %v, want %v", got, tt.want))
+		})
+	}
+}
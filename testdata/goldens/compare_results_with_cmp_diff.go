@@ -0,0 +1,43 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFoo25(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		in0 interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		want1   []byte
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, got1, err := Foo25(tt.args.in0)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Foo25() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+		if tt.wantErr {
+			// The values returned with an error aren't compared.
+			continue
+		}
+
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("%q. Foo25() got mismatch (-want +got):\n%s", tt.name, diff)
+		}
+
+		if diff := cmp.Diff(tt.want1, got1); diff != "" {
+			t.Errorf("%q. Foo25() got1 mismatch (-want +got):\n%s", tt.name, diff)
+		}
+	}
+}
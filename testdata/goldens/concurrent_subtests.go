@@ -0,0 +1,49 @@
+package testdata
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounter89_Add(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		n int64
+	}
+	type args struct {
+		n int64
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   int64
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Counter89{
+				n: tt.fields.n,
+			}
+			got := c.Add(tt.args.n)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Counter89.Add() = %v, want %v", got, tt.want))
+			t.Run("concurrent", func(t *testing.T) {
+				// Run with go test -race to detect the data races of the calls.
+				var wg sync.WaitGroup
+				for i := 0; i < 10; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						c.Add(tt.args.n)
+					}()
+				}
+				wg.Wait()
+			})
+		})
+	}
+}
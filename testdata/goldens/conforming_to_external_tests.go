@@ -0,0 +1,28 @@
+package externaltests_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cweill/gotests/testdata/externaltests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWhisper(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := externaltests.Whisper(tt.args.s)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Whisper() = %v, want %v", tt.name, got, tt.want))
+	}
+}
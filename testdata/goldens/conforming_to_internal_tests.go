@@ -0,0 +1,33 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMix41(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a Color41
+		b Color41
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Color41
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Mix41(tt.args.a, tt.args.b)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Mix41() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Mix41() = %v, want %v", tt.name, got, tt.want))
+	}
+}
@@ -0,0 +1,115 @@
+package testdata
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetch43(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		id int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Fetch43(context.Background(), tt.args.id)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Fetch43(%v) error = %v, wantErr %v", tt.name, tt.args.id, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Fetch43(%v) = %v, want %v", tt.name, tt.args.id, got, tt.want))
+	}
+}
+
+func BenchmarkFetch43(b *testing.B) {
+	type args struct {
+		id int
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		_, _ = Fetch43(context.Background(), tt.args.id)
+	}
+}
+
+func TestPing43(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		err := Ping43(context.Background())
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Ping43() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+	}
+}
+
+func BenchmarkPing43(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Ping43(context.Background())
+	}
+}
+
+func TestClient43_Do43(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		Addr string
+	}
+	type args struct {
+		req string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		c := &Client43{
+			Addr: tt.fields.Addr,
+		}
+		got := c.Do43(context.Background(), tt.args.req)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Client43.Do43(%v) = %v, want %v", tt.name, tt.args.req, got, tt.want))
+	}
+}
+
+func BenchmarkClient43_Do43(b *testing.B) {
+	type fields struct {
+		Addr string
+	}
+	type args struct {
+		req string
+	}
+	tt := struct {
+		fields fields
+		args   args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		c := &Client43{
+			Addr: tt.fields.Addr,
+		}
+		_ = c.Do43(context.Background(), tt.args.req)
+	}
+}
@@ -0,0 +1,80 @@
+package testdata
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetch43(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		ctx context.Context
+		id  int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Fetch43(tt.args.ctx, tt.args.id)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Fetch43() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Fetch43() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestPing43(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		err := Ping43(tt.args.ctx)
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Ping43() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+	}
+}
+
+func TestClient43_Do43(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		Addr string
+	}
+	type args struct {
+		ctx context.Context
+		req string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		c := &Client43{
+			Addr: tt.fields.Addr,
+		}
+		got := c.Do43(tt.args.ctx, tt.args.req)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Client43.Do43() = %v, want %v", tt.name, got, tt.want))
+	}
+}
@@ -0,0 +1,28 @@
+package testdata
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWait44(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Wait44(context.Background(), tt.args.n)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Wait44() = %v, want %v", tt.name, got, tt.want))
+	}
+}
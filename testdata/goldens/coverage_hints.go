@@ -0,0 +1,39 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Cases to cover:
+//   - if s == ""
+//   - switch base: case 2, 8; case 16; default
+//   - if err != nil
+func TestParse83(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s    string
+		base int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse83(tt.args.s, tt.args.base)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Parse83() error = %v, wantErr %v", err, tt.wantErr))
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Parse83() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounter42_Next(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		Step int
+	}
+	type in struct {
+		n int
+	}
+	cases := []struct {
+		name   string
+		fields fields
+		in     in
+		want   int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range cases {
+		c := &Counter42{
+			Step: tt.fields.Step,
+		}
+		got := c.Next(tt.in.n)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Counter42.Next(%v) = %v, want %v", tt.name, tt.in.n, got, tt.want))
+	}
+}
+
+func BenchmarkCounter42_Next(b *testing.B) {
+	type fields struct {
+		Step int
+	}
+	type in struct {
+		n int
+	}
+	tt := struct {
+		fields fields
+		in     in
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		c := &Counter42{
+			Step: tt.fields.Step,
+		}
+		_ = c.Next(tt.in.n)
+	}
+}
+
+func TestDiv42(t *testing.T) {
+	should := require.New(t)
+	type in struct {
+		a int
+		b int
+	}
+	cases := []struct {
+		name    string
+		in      in
+		want    int
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range cases {
+		got, err := Div42(tt.in.a, tt.in.b)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Div42(%v, %v) error = %v, wantErr %v", tt.name, tt.in.a, tt.in.b, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Div42(%v, %v) = %v, want %v", tt.name, tt.in.a, tt.in.b, got, tt.want))
+	}
+}
+
+func BenchmarkDiv42(b *testing.B) {
+	type in struct {
+		a int
+		b int
+	}
+	tt := struct {
+		in in
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		_, _ = Div42(tt.in.a, tt.in.b)
+	}
+}
+
+func TestReset42(t *testing.T) {
+	type in struct {
+		c *Counter42
+	}
+	cases := []struct {
+		name string
+		in   in
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range cases {
+		Reset42(tt.in.c)
+	}
+}
+
+func BenchmarkReset42(b *testing.B) {
+	type in struct {
+		c *Counter42
+	}
+	tt := struct {
+		in in
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		Reset42(tt.in.c)
+	}
+}
+
+func Test_errString42_Error(t *testing.T) {
+	should := require.New(t)
+	cases := []struct {
+		name string
+		e    errString42
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range cases {
+		got := tt.e.Error()
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. errString42.Error() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func Benchmark_errString42_Error(b *testing.B) {
+	tt := struct {
+		e errString42
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		_ = tt.e.Error()
+	}
+}
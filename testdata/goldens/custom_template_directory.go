@@ -0,0 +1,31 @@
+package testdata
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiv42(t *testing.T) {
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Div42(tt.args.a, tt.args.b)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q. Div42() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q. Div42() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package testdata
+
+import "testing"
+
+func TestDiv42(t *testing.T) {
+	// A: int
+	// B: int
+	t.Skip("TODO: test DIV42")
+}
@@ -0,0 +1,16 @@
+// Licensed under the MIT License.
+
+package testdata
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDiv42(t *testing.T) {
+	// Owner: ""
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_ = ctx
+}
@@ -0,0 +1,31 @@
+package testdata
+
+import (
+	"fmt"
+
+	"example.com/xtesting"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClamp84(t *xtesting.T) {
+	should := require.New(t)
+	type args struct {
+		v  int
+		lo int
+		hi int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *xtesting.T) {
+			got := Clamp84(tt.args.v, tt.args.lo, tt.args.hi)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Clamp84() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package testdata
+
+import (
+	"example.com/xtesting"
+	"fmt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClamp84(t *xtesting.T) {
+	should := require.New(t)
+	type args struct {
+		v  int
+		lo int
+		hi int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Clamp84(tt.args.v, tt.args.lo, tt.args.hi)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Clamp84() = %v, want %v", tt.name, got, tt.want))
+	}
+}
@@ -0,0 +1,103 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPoint69(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		x int
+		y int
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Point69
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewPoint69(tt.args.x, tt.args.y)
+			if got == nil || tt.want == nil {
+				should.Equal(got, tt.want,
+					fmt.Sprintf("NewPoint69() = %v, want %v", got, tt.want))
+			} else {
+				should.Equal(*got, *tt.want,
+					fmt.Sprintf("NewPoint69() = %v, want %v", *got, *tt.want))
+			}
+		})
+	}
+}
+
+func TestBounds69(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		ps []Point69
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *struct{ Min, Max Point69 }
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Bounds69(tt.args.ps)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Bounds69() error = %v, wantErr %v", err, tt.wantErr))
+
+			if got == nil || tt.want == nil {
+				should.Equal(got, tt.want,
+					fmt.Sprintf("Bounds69() = %v, want %v", got, tt.want))
+			} else {
+				should.Equal(*got, *tt.want,
+					fmt.Sprintf("Bounds69() = %v, want %v", *got, *tt.want))
+			}
+		})
+	}
+}
+
+func TestCut69(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s   string
+		sep string
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *string
+		want1 *string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := Cut69(tt.args.s, tt.args.sep)
+
+			if got == nil || tt.want == nil {
+				should.Equal(got, tt.want,
+					fmt.Sprintf("Cut69() got = %v, want %v", got, tt.want))
+			} else {
+				should.Equal(*got, *tt.want,
+					fmt.Sprintf("Cut69() got = %v, want %v", *got, *tt.want))
+			}
+
+			if got1 == nil || tt.want1 == nil {
+				should.Equal(got1, tt.want1,
+					fmt.Sprintf("Cut69() got1 = %v, want1 %v", got1, tt.want1))
+			} else {
+				should.Equal(*got1, *tt.want1,
+					fmt.Sprintf("Cut69() got1 = %v, want1 %v", *got1, *tt.want1))
+			}
+		})
+	}
+}
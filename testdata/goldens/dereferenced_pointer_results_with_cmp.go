@@ -0,0 +1,47 @@
+package testdata
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCut69(t *testing.T) {
+	type args struct {
+		s   string
+		sep string
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *string
+		want1 *string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := Cut69(tt.args.s, tt.args.sep)
+
+			if got == nil || tt.want == nil {
+				if got != tt.want {
+					t.Errorf("Cut69() got = %v, want %v", got, tt.want)
+				}
+			} else {
+				if diff := cmp.Diff(*tt.want, *got); diff != "" {
+					t.Errorf("Cut69() got mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if got1 == nil || tt.want1 == nil {
+				if got1 != tt.want1 {
+					t.Errorf("Cut69() got1 = %v, want1 %v", got1, tt.want1)
+				}
+			} else {
+				if diff := cmp.Diff(*tt.want1, *got1); diff != "" {
+					t.Errorf("Cut69() got1 mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
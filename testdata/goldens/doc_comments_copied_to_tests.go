@@ -0,0 +1,76 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Clamp50 returns v limited to the range [lo, hi]. If lo is greater than hi,
+// the bounds are swapped before clamping, so that the result is always
+// within the range they describe.
+//
+// For example:
+//
+//	Clamp50(5, 0, 3) // 3
+//	Clamp50(5, 3, 0) // 3
+func TestClamp50(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		v  int
+		lo int
+		hi int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Clamp50(tt.args.v, tt.args.lo, tt.args.hi)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Clamp50() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestAbs50(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		v int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Abs50(tt.args.v)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Abs50() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+// Sign50 returns -1, 0, or 1 depending on the sign of v.
+func TestSign50(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		v int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Sign50(tt.args.v)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Sign50() = %v, want %v", tt.name, got, tt.want))
+	}
+}
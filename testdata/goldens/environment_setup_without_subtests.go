@@ -0,0 +1,51 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddress64(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		// TODO: Set the environment of the test case.
+		t.Setenv("APP_HOST", "")
+		t.Setenv("APP_PORT", "")
+		got, err := Address64()
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Address64() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Address64() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestJoin64(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Join64(tt.args.a, tt.args.b)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Join64() = %v, want %v", tt.name, got, tt.want))
+	}
+}
@@ -0,0 +1,33 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiv42(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Div42(tt.args.a, tt.args.b)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Div42() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Div42() = %v, want %v", tt.name, got, tt.want))
+	}
+}
@@ -0,0 +1,39 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiv42(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       int
+		wantErrMsg string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Div42(tt.args.a, tt.args.b)
+
+			var errMsg string
+			if err != nil {
+				errMsg = err.Error()
+			}
+			should.Equal(errMsg, tt.wantErrMsg,
+				fmt.Sprintf("Div42() error = %v, wantErrMsg %v", err, tt.wantErrMsg))
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Div42() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package testdata_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cweill/gotests/testdata"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewShape41(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		name  string
+		sides int
+	}
+	tests := []struct {
+		name string
+		args args
+		want *testdata.Shape41
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := testdata.NewShape41(tt.args.name, tt.args.sides)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. NewShape41() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func ExampleNewShape41() {
+	fmt.Println(testdata.NewShape41("", 0))
+	// Output:
+}
+
+func TestShape41_Describe(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		Name string
+	}
+	type args struct {
+		prefix string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		s := &testdata.Shape41{
+			Name: tt.fields.Name,
+		}
+		got := s.Describe(tt.args.prefix)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. testdata.Shape41.Describe() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func ExampleShape41_Describe() {
+	fmt.Println(new(testdata.Shape41).Describe(""))
+	// Output:
+}
+
+func TestMix41(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a testdata.Color41
+		b testdata.Color41
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    testdata.Color41
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := testdata.Mix41(tt.args.a, tt.args.b)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Mix41() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Mix41() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func ExampleMix41() {
+	fmt.Println(testdata.Mix41(0, 0))
+	// Output:
+}
+
+func TestRepeat41(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := testdata.Repeat41(tt.args.s, tt.args.n)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Repeat41() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func ExampleRepeat41() {
+	fmt.Println(testdata.Repeat41("", 0))
+	// Output:
+}
+
+func TestOrigin41(t *testing.T) {
+	// TODO: Construct the arguments of unexported types, which this external
+	// test package can't reference.
+	t.Skip("can't construct the arguments of unexported types")
+}
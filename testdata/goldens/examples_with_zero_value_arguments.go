@@ -0,0 +1,85 @@
+package testdata_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cweill/gotests/testdata"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribe48(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		p    testdata.Point48
+		l    testdata.Level48
+		tags map[string]bool
+		next *testdata.Point48
+		d    time.Duration
+		opts []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := testdata.Describe48(tt.args.p, tt.args.l, tt.args.tags, tt.args.next, tt.args.d, tt.args.opts...)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Describe48() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func ExampleDescribe48() {
+	fmt.Println(testdata.Describe48(testdata.Point48{}, 0, nil, nil, 0))
+	// Output:
+}
+
+func TestGrid48(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		size [2]int
+		fn   func(testdata.Point48) bool
+		ok   bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []testdata.Point48
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := testdata.Grid48(tt.args.size, tt.args.fn, tt.args.ok)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Grid48() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Grid48() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func ExampleGrid48() {
+	fmt.Println(testdata.Grid48([2]int{}, nil, false))
+	// Output:
+}
+
+func TestTouch48(t *testing.T) {
+	type args struct {
+		p *testdata.Point48
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		testdata.Touch48(tt.args.p)
+	}
+}
@@ -0,0 +1,28 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepeat41(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Repeat41(tt.args.s, tt.args.n)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Repeat41() = %v, want %v", tt.name, got, tt.want))
+	}
+}
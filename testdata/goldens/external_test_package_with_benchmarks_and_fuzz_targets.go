@@ -0,0 +1,91 @@
+package testdata_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cweill/gotests/testdata"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMix41(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a testdata.Color41
+		b testdata.Color41
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    testdata.Color41
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := testdata.Mix41(tt.args.a, tt.args.b)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Mix41() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Mix41() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func BenchmarkMix41(b *testing.B) {
+	type args struct {
+		a testdata.Color41
+		b testdata.Color41
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		_, _ = testdata.Mix41(tt.args.a, tt.args.b)
+	}
+}
+
+func TestRepeat41(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := testdata.Repeat41(tt.args.s, tt.args.n)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Repeat41() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func BenchmarkRepeat41(b *testing.B) {
+	type args struct {
+		s string
+		n int
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		_ = testdata.Repeat41(tt.args.s, tt.args.n)
+	}
+}
+
+func FuzzRepeat41(f *testing.F) {
+	f.Add("", 0)
+	f.Fuzz(func(t *testing.T, s string, n int) {
+		_ = testdata.Repeat41(s, n)
+	})
+}
@@ -0,0 +1,68 @@
+package testdata_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/cweill/gotests/testdata"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultOptions63(t *testing.T) {
+	// TODO: Check the results. The signature has unexported types, which this
+	// external test package can't declare test cases of.
+	_ = testdata.DefaultOptions63()
+}
+
+func TestRun63(t *testing.T) {
+	// TODO: Check the results. The signature has unexported types, which this
+	// external test package can't declare test cases of.
+	w := &bytes.Buffer{}
+	var name string
+	opts := testdata.DefaultOptions63()
+	_, _ = testdata.Run63(w, name, opts)
+}
+
+func TestLog63(t *testing.T) {
+	// TODO: Construct the arguments of unexported types, which this external
+	// test package can't reference.
+	t.Skip("can't construct the arguments of unexported types")
+}
+
+func TestOpen63(t *testing.T) {
+	// TODO: Check the results. The signature has unexported types, which this
+	// external test package can't declare test cases of.
+	var path string
+	_ = testdata.Open63(path)
+}
+
+func TestLogger63_Print(t *testing.T) {
+	// TODO: Check the results. The signature has unexported types, which this
+	// external test package can't declare test cases of.
+	l := &testdata.Logger63{}
+	opts := testdata.DefaultOptions63()
+	var args []string
+	l.Print(opts, args...)
+}
+
+func TestCount63(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		items []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testdata.Count63(tt.args.items...)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Count63() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package testdata_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cweill/gotests/testdata"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCount63(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		items []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testdata.Count63(tt.args.items...)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Count63() = %v, want %v", got, tt.want))
+		})
+	}
+}
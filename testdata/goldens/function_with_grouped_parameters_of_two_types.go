@@ -0,0 +1,30 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat88(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a int
+		b int
+		c string
+		d string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Format88(tt.args.a, tt.args.b, tt.args.c, tt.args.d)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Format88() = %v, want %v", tt.name, got, tt.want))
+	}
+}
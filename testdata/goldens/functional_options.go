@@ -0,0 +1,79 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithAddr79(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		addr string
+	}
+	tests := []struct {
+		name string
+		args args
+		want ServerOption
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WithAddr79(tt.args.addr)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("WithAddr79() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestWithTimeout79(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		d time.Duration
+	}
+	tests := []struct {
+		name string
+		args args
+		want ServerOption
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WithTimeout79(tt.args.d)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("WithTimeout79() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestNew79(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		opts []ServerOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Server79
+	}{
+		{
+			name: "default opts",
+			args: args{
+				// Available options: WithAddr79, WithTimeout79.
+				opts: []ServerOption{},
+			},
+		},
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := New79(tt.args.opts...)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("New79() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package testdata_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cweill/gotests/testdata"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithAddr79(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		addr string
+	}
+	tests := []struct {
+		name string
+		args args
+		want testdata.ServerOption
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testdata.WithAddr79(tt.args.addr)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("WithAddr79() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestWithTimeout79(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		d time.Duration
+	}
+	tests := []struct {
+		name string
+		args args
+		want testdata.ServerOption
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testdata.WithTimeout79(tt.args.d)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("WithTimeout79() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestNew79(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		opts []testdata.ServerOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want *testdata.Server79
+	}{
+		{
+			name: "default opts",
+			args: args{
+				// Available options: testdata.WithAddr79, testdata.WithTimeout79.
+				opts: []testdata.ServerOption{},
+			},
+		},
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testdata.New79(tt.args.opts...)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("New79() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse90(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Parse90(tt.args.s)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Parse90() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Parse90() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestWriter90_Len(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		buf []byte
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		w := &Writer90{
+			buf: tt.fields.buf,
+		}
+		got := w.Len()
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Writer90.Len() = %v, want %v", tt.name, got, tt.want))
+	}
+}
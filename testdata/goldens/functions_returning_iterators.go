@@ -0,0 +1,63 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvens86(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Evens86(tt.args.n)
+		var gotValues []int
+		for v := range got {
+			gotValues = append(gotValues, v)
+		}
+		should.Equal(gotValues, tt.want,
+			fmt.Sprintf("%q. Evens86() = %v, want %v", tt.name, gotValues, tt.want))
+	}
+}
+
+func TestEnumerate86(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []struct {
+			K int
+			V string
+		}
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Enumerate86(tt.args.s)
+		var gotValues []struct {
+			K int
+			V string
+		}
+		for k, v := range got {
+			gotValues = append(gotValues, struct {
+				K int
+				V string
+			}{k, v})
+		}
+		should.Equal(gotValues, tt.want,
+			fmt.Sprintf("%q. Enumerate86() = %v, want %v", tt.name, gotValues, tt.want))
+	}
+}
@@ -0,0 +1,64 @@
+package sibling
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBar(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Bar(tt.args.s)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Bar() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestBaz(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Baz(tt.args.s)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Baz() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func BenchmarkBaz(b *testing.B) {
+	type args struct {
+		s string
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		_ = Baz(tt.args.s)
+	}
+}
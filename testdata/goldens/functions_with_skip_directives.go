@@ -0,0 +1,47 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSum46(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Sum46(tt.args.a, tt.args.b)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Sum46() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestDouble46(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Double46(tt.args.n)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Double46() = %v, want %v", tt.name, got, tt.want))
+	}
+}
@@ -0,0 +1,200 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCelsius61_Fahrenheit(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name string
+		c    Celsius61
+		want float64
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.c.Fahrenheit()
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Celsius61.Fahrenheit() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestCelsius61_Plus(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		d float64
+	}
+	tests := []struct {
+		name string
+		c    Celsius61
+		args args
+		want Celsius61
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.c.Plus(tt.args.d)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Celsius61.Plus() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func FuzzCelsius61_Plus(f *testing.F) {
+	f.Add(float64(0))
+	f.Fuzz(func(t *testing.T, d float64) {
+		c := Celsius61(0)
+		_ = c.Plus(d)
+	})
+}
+
+func TestCelsius61_Warm(t *testing.T) {
+	type args struct {
+		d float64
+	}
+	tests := []struct {
+		name string
+		c    Celsius61
+		args args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.c
+			c.Warm(tt.args.d)
+		})
+	}
+}
+
+func FuzzCelsius61_Warm(f *testing.F) {
+	f.Add(float64(0))
+	f.Fuzz(func(t *testing.T, d float64) {
+		c := new(Celsius61)
+		c.Warm(d)
+	})
+}
+
+func TestReadings_Max(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name string
+		r    Readings
+		want Celsius61
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.r.Max()
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Readings.Max() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestReadings_Add(t *testing.T) {
+	type args struct {
+		c Celsius61
+	}
+	tests := []struct {
+		name string
+		r    Readings
+		args args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.r
+			r.Add(tt.args.c)
+		})
+	}
+}
+
+func TestReadings_Above(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		min float64
+	}
+	tests := []struct {
+		name string
+		r    Readings
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.r.Above(tt.args.min)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Readings.Above() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func FuzzReadings_Above(f *testing.F) {
+	f.Add(float64(0))
+	f.Fuzz(func(t *testing.T, min float64) {
+		r := Readings(nil)
+		_ = r.Above(min)
+	})
+}
+
+func TestSite_Rename(t *testing.T) {
+	type fields struct {
+		Name string
+	}
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Site{
+				Name: tt.fields.Name,
+			}
+			s.Rename(tt.args.name)
+		})
+	}
+}
+
+func FuzzSite_Rename(f *testing.F) {
+	f.Add("")
+	f.Fuzz(func(t *testing.T, name string) {
+		s := &Site{}
+		s.Rename(name)
+	})
+}
+
+func TestDegrees_Kelvin(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name string
+		d    Degrees
+		want float64
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.Kelvin()
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Degrees.Kelvin() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse38(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s      string
+		b      []byte
+		base   int64
+		signed bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int64
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Parse38(tt.args.s, tt.args.b, tt.args.base, tt.args.signed)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Parse38() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Parse38() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func FuzzParse38(f *testing.F) {
+	f.Add("", []byte(""), int64(0), false)
+	f.Fuzz(func(t *testing.T, s string, b []byte, base int64, signed bool) {
+		_, err := Parse38(s, b, base, signed)
+		if err != nil {
+			t.Skip(err)
+		}
+	})
+}
+
+func TestPerson_Rename38(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		FirstName string
+		LastName  string
+		Age       int
+		Gender    string
+		Siblings  []*Person
+	}
+	type args struct {
+		first string
+		age   uint8
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		p := &Person{
+			FirstName: tt.fields.FirstName,
+			LastName:  tt.fields.LastName,
+			Age:       tt.fields.Age,
+			Gender:    tt.fields.Gender,
+			Siblings:  tt.fields.Siblings,
+		}
+		got := p.Rename38(tt.args.first, tt.args.age)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Person.Rename38() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func FuzzPerson_Rename38(f *testing.F) {
+	f.Add("", uint8(0))
+	f.Fuzz(func(t *testing.T, first string, age uint8) {
+		p := &Person{}
+		_ = p.Rename38(first, age)
+	})
+}
+
+func TestLookup38(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		m   map[string]int
+		key string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Lookup38(tt.args.m, tt.args.key)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Lookup38() = %v, want %v", tt.name, got, tt.want))
+	}
+}
@@ -0,0 +1,96 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMap39(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s []int
+		f func(int) int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Map39[int, int](tt.args.s, tt.args.f)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Map39() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestSum39(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		m map[int]int64
+	}
+	tests := []struct {
+		name string
+		args args
+		want int64
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Sum39[int, int64](tt.args.m)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Sum39() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestStack39_Push(t *testing.T) {
+	type fields struct {
+		items []int
+	}
+	type args struct {
+		v int
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		s := &Stack39[int]{
+			items: tt.fields.items,
+		}
+		s.Push(tt.args.v)
+	}
+}
+
+func TestStack39_Pop(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		items []int
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   int
+		want1  bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		s := &Stack39[int]{
+			items: tt.fields.items,
+		}
+		got, got1 := s.Pop()
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Stack39[int].Pop() got = %v, want %v", tt.name, got, tt.want))
+
+		should.Equal(got1, tt.want1,
+			fmt.Sprintf("%q. Stack39[int].Pop() got1 = %v, want1 %v", tt.name, got1, tt.want1))
+	}
+}
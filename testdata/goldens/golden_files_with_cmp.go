@@ -0,0 +1,98 @@
+package testdata
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender59(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		title string
+		items []string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Render59(tt.args.title, tt.args.items)
+			golden := filepath.Join("testdata", t.Name()+".golden")
+			if *update {
+				should.NoError(os.MkdirAll(filepath.Dir(golden), 0755))
+				should.NoError(os.WriteFile(golden, []byte(got), 0644))
+			}
+			want, err := os.ReadFile(golden)
+			should.NoError(err)
+			if diff := cmp.Diff(string(want), got); diff != "" {
+				t.Errorf("Render59() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMarshal59(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		v interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Marshal59(tt.args.v)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Marshal59() error = %v, wantErr %v", err, tt.wantErr))
+
+			golden := filepath.Join("testdata", t.Name()+".golden")
+			if *update {
+				should.NoError(os.MkdirAll(filepath.Dir(golden), 0755))
+				should.NoError(os.WriteFile(golden, got, 0644))
+			}
+			want, err := os.ReadFile(golden)
+			should.NoError(err)
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("Marshal59() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCount59(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Count59(tt.args.s)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Count59() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// update rewrites the golden files of the tests with their results.
+var update = flag.Bool("update", false, "update the golden files")
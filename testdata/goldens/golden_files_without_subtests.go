@@ -0,0 +1,89 @@
+package testdata
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender59(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		title string
+		items []string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Render59(tt.args.title, tt.args.items)
+		golden := filepath.Join("testdata", "TestRender59", tt.name+".golden")
+		if *update {
+			should.NoError(os.MkdirAll(filepath.Dir(golden), 0755))
+			should.NoError(os.WriteFile(golden, []byte(got), 0644))
+		}
+		want, err := os.ReadFile(golden)
+		should.NoError(err)
+		should.Equal(got, string(want),
+			fmt.Sprintf("%q. Render59() = %v, want %v", tt.name, got, string(want)))
+	}
+}
+
+func TestMarshal59(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		v interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Marshal59(tt.args.v)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Marshal59() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		golden := filepath.Join("testdata", "TestMarshal59", tt.name+".golden")
+		if *update {
+			should.NoError(os.MkdirAll(filepath.Dir(golden), 0755))
+			should.NoError(os.WriteFile(golden, got, 0644))
+		}
+		want, err := os.ReadFile(golden)
+		should.NoError(err)
+		should.Equal(got, want,
+			fmt.Sprintf("%q. Marshal59() = %v, want %v", tt.name, got, want))
+	}
+}
+
+func TestCount59(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Count59(tt.args.s)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Count59() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+// update rewrites the golden files of the tests with their results.
+var update = flag.Bool("update", false, "update the golden files")
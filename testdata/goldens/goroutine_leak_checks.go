@@ -0,0 +1,31 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+func TestSum78(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	should := require.New(t)
+	type args struct {
+		nums []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Sum78(tt.args.nums)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Sum78() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package testdata
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealth49(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		wantCode int
+		wantBody string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantCode == 0 {
+				tt.wantCode = http.StatusOK
+			}
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			rec := httptest.NewRecorder()
+			Health49(rec, req)
+			should.Equal(rec.Code, tt.wantCode,
+				fmt.Sprintf("Health49() code = %v, want %v", rec.Code, tt.wantCode))
+			should.Equal(rec.Body.String(), tt.wantBody,
+				fmt.Sprintf("Health49() body = %v, want %v", rec.Body.String(), tt.wantBody))
+		})
+	}
+}
+
+func TestServer49_Greet(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		Greeting string
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		method   string
+		target   string
+		wantCode int
+		wantBody string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server49{
+				Greeting: tt.fields.Greeting,
+			}
+			if tt.wantCode == 0 {
+				tt.wantCode = http.StatusOK
+			}
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			rec := httptest.NewRecorder()
+			s.Greet(rec, req)
+			should.Equal(rec.Code, tt.wantCode,
+				fmt.Sprintf("Server49.Greet() code = %v, want %v", rec.Code, tt.wantCode))
+			should.Equal(rec.Body.String(), tt.wantBody,
+				fmt.Sprintf("Server49.Greet() body = %v, want %v", rec.Body.String(), tt.wantBody))
+		})
+	}
+}
+
+func TestRedirect49(t *testing.T) {
+	type args struct {
+		w      http.ResponseWriter
+		r      *http.Request
+		target string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Redirect49(tt.args.w, tt.args.r, tt.args.target)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package testdata
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHealth49(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		wantCode int
+		wantBody string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		if tt.wantCode == 0 {
+			tt.wantCode = http.StatusOK
+		}
+		req := httptest.NewRequest(tt.method, tt.target, nil)
+		rec := httptest.NewRecorder()
+		Health49(rec, req)
+		should.Equal(rec.Code, tt.wantCode,
+			fmt.Sprintf("%q. Health49() code = %v, want %v", tt.name, rec.Code, tt.wantCode))
+		should.Equal(rec.Body.String(), tt.wantBody,
+			fmt.Sprintf("%q. Health49() body = %v, want %v", tt.name, rec.Body.String(), tt.wantBody))
+	}
+}
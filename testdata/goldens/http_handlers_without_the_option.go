@@ -0,0 +1,22 @@
+package testdata
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestHealth49(t *testing.T) {
+	type args struct {
+		w http.ResponseWriter
+		r *http.Request
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		Health49(tt.args.w, tt.args.r)
+	}
+}
@@ -0,0 +1,31 @@
+package testdata
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFoo8(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		b *Bar
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *Bar
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Foo8(tt.args.b)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Foo8() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Foo8() = %v, want %v", tt.name, got, tt.want))
+	}
+}
@@ -0,0 +1,42 @@
+package loadpkg
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cweill/gotests/testdata/loadpkg/dep"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmit(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		lvl dep.Level
+		msg string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &mockSink{}
+			err := Emit(s, tt.args.lvl, tt.args.msg)
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Emit() error = %v, wantErr %v", err, tt.wantErr))
+		})
+	}
+}
+
+// mockSink is a mock of dep.Sink that counts the calls of its methods.
+type mockSink struct {
+	PutCalls int
+}
+
+func (m *mockSink) Put(string) (r0 error) {
+	m.PutCalls++
+	return
+}
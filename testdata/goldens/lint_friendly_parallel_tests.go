@@ -0,0 +1,32 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:tparallel // Only the subtests run in parallel.
+func TestTitle85(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt := tt
+			t.Parallel()
+			should := require.New(t)
+			got := Title85(tt.args.s)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Title85() = %v, want %v", got, tt.want))
+		})
+	}
+}
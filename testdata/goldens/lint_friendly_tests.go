@@ -0,0 +1,31 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:paralleltest // The test cases run one after the other.
+func TestTitle85(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt := tt
+			got := Title85(tt.args.s)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Title85() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiv58(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a int
+		b int
+	}
+	tests := map[string]struct {
+		args    args
+		want    int
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Div58(tt.args.a, tt.args.b)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Div58() error = %v, wantErr %v", err, tt.wantErr))
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Div58() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestLog58(t *testing.T) {
+	type args struct {
+		msg string
+	}
+	tests := map[string]struct {
+		args args
+	}{
+		// TODO: Add test cases.
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			Log58(tt.args.msg)
+		})
+	}
+}
+
+func TestReset58(t *testing.T) {
+	tests := map[string]struct {
+	}{
+		// TODO: Add test cases.
+	}
+	for name := range tests {
+		t.Run(name, func(t *testing.T) {
+			Reset58()
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiv58(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a int
+		b int
+	}
+	tests := map[string]struct {
+		args    args
+		want    int
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for name, tt := range tests {
+		got, err := Div58(tt.args.a, tt.args.b)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Div58() error = %v, wantErr %v", name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Div58() = %v, want %v", name, got, tt.want))
+	}
+}
+
+func TestLog58(t *testing.T) {
+	type args struct {
+		msg string
+	}
+	tests := map[string]struct {
+		args args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		Log58(tt.args.msg)
+	}
+}
+
+func TestReset58(t *testing.T) {
+	tests := map[string]struct {
+	}{
+		// TODO: Add test cases.
+	}
+	for range tests {
+		Reset58()
+	}
+}
@@ -0,0 +1,112 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBarBar100(t *testing.T) {
+	tests := []struct {
+		name    string
+		b       *Bar
+		i       interface{}
+		wantErr bool
+	}{
+		{
+			name:    "Basic test",
+			b:       &Bar{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		if err := tt.b.Bar100(tt.i); (err != nil) != tt.wantErr {
+			t.Errorf("%q. Bar100() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestBaz100(t *testing.T) {
+	tests := []struct {
+		name string
+		f    *float64
+		want float64
+	}{
+		{
+			name: "Basic test",
+			f:    func() *float64 { var x float64 = 64; return &x }(),
+			want: 64,
+		},
+	}
+	// TestBaz100 contains a comment.
+	for _, tt := range tests {
+		if got := baz100(tt.f); got != tt.want {
+			t.Errorf("%q. baz100() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFoo100(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		strs []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*Bar
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Foo100(tt.args.strs)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Foo100() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Foo100() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestBar_Bar100(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		i interface{}
+	}
+	tests := []struct {
+		name    string
+		b       *Bar
+		args    args
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		b := &Bar{}
+		err := b.Bar100(tt.args.i)
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Bar.Bar100() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+	}
+}
+
+func Test_baz100(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		f *float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := baz100(tt.args.f)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. baz100() = %v, want %v", tt.name, got, tt.want))
+	}
+}
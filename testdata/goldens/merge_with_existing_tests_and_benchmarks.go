@@ -0,0 +1,80 @@
+package testdata
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestUpper40 is hand written.
+func TestUpper40(t *testing.T) {
+	if got := Upper40("a"); got != "A" {
+		t.Errorf("Upper40() = %v, want %v", got, "A")
+	}
+}
+
+func BenchmarkLower40(b *testing.B) {
+	s := strings.Repeat("A", 64)
+	for i := 0; i < b.N; i++ {
+		Lower40(s)
+	}
+}
+
+func TestLower40(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Lower40(tt.args.s)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Lower40() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestTrim40(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Trim40(tt.args.s)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Trim40() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Trim40() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func BenchmarkTrim40(b *testing.B) {
+	type args struct {
+		s string
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		_, _ = Trim40(tt.args.s)
+	}
+}
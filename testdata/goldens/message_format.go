@@ -0,0 +1,31 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslate60(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		p  Point60
+		dx int
+		dy int
+	}
+	tests := []struct {
+		name string
+		args args
+		want Point60
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Translate60(tt.args.p, tt.args.dx, tt.args.dy)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Translate60() = %+v, want %+v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStack51_Push(t *testing.T) {
+	type fields struct {
+		items []int
+	}
+	type args struct {
+		v int
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		s := &Stack51{
+			items: tt.fields.items,
+		}
+		s.Push(tt.args.v)
+	}
+}
+
+func TestStack51_Len(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		items []int
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		s := &Stack51{
+			items: tt.fields.items,
+		}
+		got := s.Len()
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Stack51.Len() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestNewStack51(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		items []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Stack51
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := NewStack51(tt.args.items...)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. NewStack51() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestQueue51_Len(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		items []int
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		q := &Queue51{
+			items: tt.fields.items,
+		}
+		got := q.Len()
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Queue51.Len() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestQueue51_Peek(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		items []int
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		q := Queue51{
+			items: tt.fields.items,
+		}
+		got := q.Peek()
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Queue51.Peek() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestMax51(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Max51(tt.args.a, tt.args.b)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Max51() = %v, want %v", tt.name, got, tt.want))
+	}
+}
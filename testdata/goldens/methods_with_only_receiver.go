@@ -0,0 +1,52 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReader90_Len(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		buf []byte
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		r := &Reader90{
+			buf: tt.fields.buf,
+		}
+		got := r.Len()
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Reader90.Len() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestReader90_Reset(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		buf []byte
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []byte
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		r := &Reader90{
+			buf: tt.fields.buf,
+		}
+		got := r.Reset()
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Reader90.Reset() = %v, want %v", tt.name, got, tt.want))
+	}
+}
@@ -0,0 +1,194 @@
+package testdata
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopy45(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name    string
+		want    int64
+		wantDst string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		dst := &bytes.Buffer{}
+		src := &mockReader{}
+		got, err := Copy45(dst, src)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Copy45() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Copy45() = %v, want %v", tt.name, got, tt.want))
+		gotDst := dst.String()
+		should.Equal(gotDst, tt.wantDst,
+			fmt.Sprintf("%q. Copy45() = %v, want %v", tt.name, gotDst, tt.wantDst))
+	}
+}
+
+func BenchmarkCopy45(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		dst := &bytes.Buffer{}
+		src := &mockReader{}
+		_, _ = Copy45(dst, src)
+	}
+}
+
+func TestDescribe45(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		prefix string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		s := &mockStringer{}
+		got := Describe45(s, tt.args.prefix)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Describe45() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func BenchmarkDescribe45(b *testing.B) {
+	type args struct {
+		prefix string
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		s := &mockStringer{}
+		_ = Describe45(s, tt.args.prefix)
+	}
+}
+
+func TestLog45(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		// TODO: Add test cases.
+	}
+	for range tests {
+		l := &mockLogger45{}
+		Log45(l)
+	}
+}
+
+func BenchmarkLog45(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		l := &mockLogger45{}
+		Log45(l)
+	}
+}
+
+func TestSync45(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s   Store45 // TODO: Mock Store45.
+		key string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		err := Sync45(tt.args.s, tt.args.key)
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Sync45() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+	}
+}
+
+func BenchmarkSync45(b *testing.B) {
+	type args struct {
+		s   Store45 // TODO: Mock Store45.
+		key string
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		_ = Sync45(tt.args.s, tt.args.key)
+	}
+}
+
+func TestWrap45(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		err error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		err := Wrap45(tt.args.err)
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Wrap45() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+	}
+}
+
+func BenchmarkWrap45(b *testing.B) {
+	type args struct {
+		err error
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		_ = Wrap45(tt.args.err)
+	}
+}
+
+// mockReader is a mock of io.Reader that counts the calls of its methods.
+type mockReader struct {
+	ReadCalls int
+}
+
+func (m *mockReader) Read([]byte) (r0 int, r1 error) {
+	m.ReadCalls++
+	return
+}
+
+// mockStringer is a mock of fmt.Stringer that counts the calls of its methods.
+type mockStringer struct {
+	StringCalls int
+}
+
+func (m *mockStringer) String() (r0 string) {
+	m.StringCalls++
+	return
+}
+
+// mockLogger45 is a mock of Logger45 that counts the calls of its methods.
+type mockLogger45 struct {
+	LogfCalls int
+}
+
+func (m *mockLogger45) Logf(string, ...interface{}) {
+	m.LogfCalls++
+}
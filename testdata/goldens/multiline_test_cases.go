@@ -0,0 +1,96 @@
+package testdata
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBox81(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		x int
+		y int
+		z int
+	}
+	tests := []struct {
+		name string
+		args args
+		want Box81
+	}{
+		{
+			name: "x=0, y=0, z=0",
+			args: args{
+				x: 0,
+				y: 0,
+				z: 0,
+			},
+		},
+		{
+			name: "x=min, y=min, z=min",
+			args: args{
+				x: math.MinInt,
+				y: math.MinInt,
+				z: math.MinInt,
+			},
+		},
+		{
+			name: "x=max, y=max, z=max",
+			args: args{
+				x: math.MaxInt,
+				y: math.MaxInt,
+				z: math.MaxInt,
+			},
+		},
+		{
+			name: "x=-1, y=-1, z=-1",
+			args: args{
+				x: -1,
+				y: -1,
+				z: -1,
+			},
+		},
+		{
+			name: "x=1, y=1, z=1",
+			args: args{
+				x: 1,
+				y: 1,
+				z: 1,
+			},
+		},
+		{
+			name: "x=0, y=0, z=min",
+			args: args{
+				x: 0,
+				y: 0,
+				z: math.MinInt,
+			},
+		},
+		{
+			name: "x=0, y=0, z=max",
+			args: args{
+				x: 0,
+				y: 0,
+				z: math.MaxInt,
+			},
+		},
+		{
+			name: "x=0, y=0, z=-1",
+			args: args{
+				x: 0,
+				y: 0,
+				z: -1,
+			},
+		},
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewBox81(tt.args.x, tt.args.y, tt.args.z)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("NewBox81() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplit72(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		want1   string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, err := Split72(tt.args.s)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Split72() error = %v, wantErr %v", err, tt.wantErr))
+			if tt.wantErr {
+				// The values returned with an error aren't compared.
+				return
+			}
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Split72() got = %v, want %v", got, tt.want))
+
+			should.Equal(got1, tt.want1,
+				fmt.Sprintf("Split72() got1 = %v, want1 %v", got1, tt.want1))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplit72(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		want1   string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, got1, err := Split72(tt.args.s)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Split72() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+		if tt.wantErr {
+			// The values returned with an error aren't compared.
+			continue
+		}
+
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("%q. Split72() got mismatch (-want +got):\n%s", tt.name, diff)
+		}
+
+		if diff := cmp.Diff(tt.want1, got1); diff != "" {
+			t.Errorf("%q. Split72() got1 mismatch (-want +got):\n%s", tt.name, diff)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse56(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantN    int
+		wantRest string
+		wantErr  bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotN, gotRest, err := Parse56(tt.args.s)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Parse56() error = %v, wantErr %v", err, tt.wantErr))
+			if tt.wantErr {
+				// The values returned with an error aren't compared.
+				return
+			}
+
+			should.Equal(gotN, tt.wantN,
+				fmt.Sprintf("Parse56() gotN = %v, wantN %v", gotN, tt.wantN))
+
+			should.Equal(gotRest, tt.wantRest,
+				fmt.Sprintf("Parse56() gotRest = %v, wantRest %v", gotRest, tt.wantRest))
+		})
+	}
+}
+
+func TestCut56(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s   string
+		sep byte
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantBefore string
+		wantAfter  string
+		wantFound  bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBefore, gotAfter, gotFound := Cut56(tt.args.s, tt.args.sep)
+
+			should.Equal(gotBefore, tt.wantBefore,
+				fmt.Sprintf("Cut56() gotBefore = %v, wantBefore %v", gotBefore, tt.wantBefore))
+
+			should.Equal(gotAfter, tt.wantAfter,
+				fmt.Sprintf("Cut56() gotAfter = %v, wantAfter %v", gotAfter, tt.wantAfter))
+
+			should.Equal(gotFound, tt.wantFound,
+				fmt.Sprintf("Cut56() gotFound = %v, wantFound %v", gotFound, tt.wantFound))
+		})
+	}
+}
+
+func TestCheck56(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantOk  bool
+		want1   bool
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotOk, got1, err := Check56(tt.args.s)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Check56() error = %v, wantErr %v", err, tt.wantErr))
+			if tt.wantErr {
+				// The values returned with an error aren't compared.
+				return
+			}
+
+			should.Equal(gotOk, tt.wantOk,
+				fmt.Sprintf("Check56() gotOk = %v, wantOk %v", gotOk, tt.wantOk))
+
+			should.Equal(got1, tt.want1,
+				fmt.Sprintf("Check56() got1 = %v, want1 %v", got1, tt.want1))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJoin68(t *testing.T) {
+	type args struct {
+		sep   string
+		parts []string
+	}
+	tests := []struct {
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case_%d", i), func(t *testing.T) {
+			tt := tt
+			t.Parallel()
+			should := require.New(t)
+			got := Join68(tt.args.sep, tt.args.parts...)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Join68() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestParse68(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		args    args
+		want    int
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case_%d", i), func(t *testing.T) {
+			tt := tt
+			t.Parallel()
+			should := require.New(t)
+			got, err := Parse68(tt.args.s)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Parse68() error = %v, wantErr %v", err, tt.wantErr))
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Parse68() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestReset68(t *testing.T) {
+	tests := []struct {
+	}{
+		// TODO: Add test cases.
+	}
+	for i := range tests {
+		t.Run(fmt.Sprintf("case_%d", i), func(t *testing.T) {
+			t.Parallel()
+
+			Reset68()
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex57(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s []int
+		i int
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         int
+		wantPanic    bool
+		wantPanicMsg string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				should.Equal(r != nil, tt.wantPanic,
+					fmt.Sprintf("Index57() panic = %v, wantPanic %v", r, tt.wantPanic))
+				if r != nil && tt.wantPanicMsg != "" {
+					should.Equal(fmt.Sprint(r), tt.wantPanicMsg,
+						fmt.Sprintf("Index57() panic = %v, wantPanicMsg %v", r, tt.wantPanicMsg))
+				}
+			}()
+			got := Index57(tt.args.s, tt.args.i)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Index57() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestMustPositive57(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantPanic    bool
+		wantPanicMsg string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				should.Equal(r != nil, tt.wantPanic,
+					fmt.Sprintf("MustPositive57() panic = %v, wantPanic %v", r, tt.wantPanic))
+				if r != nil && tt.wantPanicMsg != "" {
+					should.Equal(fmt.Sprint(r), tt.wantPanicMsg,
+						fmt.Sprintf("MustPositive57() panic = %v, wantPanicMsg %v", r, tt.wantPanicMsg))
+				}
+			}()
+			MustPositive57(tt.args.n)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex57(t *testing.T) {
+	type args struct {
+		s []int
+		i int
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         int
+		wantPanic    bool
+		wantPanicMsg string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				require.Equal(t, tt.wantPanic, r != nil)
+				if r != nil && tt.wantPanicMsg != "" {
+					require.Equal(t, tt.wantPanicMsg, fmt.Sprint(r))
+				}
+			}()
+			got := Index57(tt.args.s, tt.args.i)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMustPositive57(t *testing.T) {
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantPanic    bool
+		wantPanicMsg string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				require.Equal(t, tt.wantPanic, r != nil)
+				if r != nil && tt.wantPanicMsg != "" {
+					require.Equal(t, tt.wantPanicMsg, fmt.Sprint(r))
+				}
+			}()
+			MustPositive57(tt.args.n)
+		})
+	}
+}
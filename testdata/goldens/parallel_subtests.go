@@ -0,0 +1,107 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounter42_Next(t *testing.T) {
+	type fields struct {
+		Step int
+	}
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt := tt
+			t.Parallel()
+			should := require.New(t)
+			c := &Counter42{
+				Step: tt.fields.Step,
+			}
+			got := c.Next(tt.args.n)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Counter42.Next() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestDiv42(t *testing.T) {
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt := tt
+			t.Parallel()
+			should := require.New(t)
+			got, err := Div42(tt.args.a, tt.args.b)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Div42() error = %v, wantErr %v", err, tt.wantErr))
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Div42() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestReset42(t *testing.T) {
+	type args struct {
+		c *Counter42
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt := tt
+			t.Parallel()
+
+			Reset42(tt.args.c)
+		})
+	}
+}
+
+func Test_errString42_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		e    errString42
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt := tt
+			t.Parallel()
+			should := require.New(t)
+			got := tt.e.Error()
+			should.Equal(got, tt.want,
+				fmt.Sprintf("errString42.Error() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounter42_Next(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		Step int
+	}
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		c := &Counter42{
+			Step: tt.fields.Step,
+		}
+		got := c.Next(tt.args.n)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Counter42.Next() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestDiv42(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Div42(tt.args.a, tt.args.b)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Div42() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Div42() = %v, want %v", tt.name, got, tt.want))
+	}
+}
+
+func TestReset42(t *testing.T) {
+	type args struct {
+		c *Counter42
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		Reset42(tt.args.c)
+	}
+}
+
+func Test_errString42_Error(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name string
+		e    errString42
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := tt.e.Error()
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. errString42.Error() = %v, want %v", tt.name, got, tt.want))
+	}
+}
@@ -0,0 +1,98 @@
+package testdata
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestList96(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		dir string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.args.dir == "" {
+				tt.args.dir = t.TempDir()
+			}
+			got, err := List96(tt.args.dir)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("List96() error = %v, wantErr %v", err, tt.wantErr))
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("List96() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestSave96(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		path string
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.args.path == "" {
+				tt.args.path = filepath.Join(t.TempDir(), "path")
+			}
+			err := Save96(tt.args.path, tt.args.data)
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Save96() error = %v, wantErr %v", err, tt.wantErr))
+		})
+	}
+}
+
+func TestSize96(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		f *os.File
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int64
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.args.f == nil {
+				f, err := os.CreateTemp(t.TempDir(), "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				t.Cleanup(func() { f.Close() })
+				tt.args.f = f
+			}
+			got, err := Size96(tt.args.f)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Size96() error = %v, wantErr %v", err, tt.wantErr))
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Size96() = %v, want %v", got, tt.want))
+		})
+	}
+}
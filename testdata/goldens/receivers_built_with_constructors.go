@@ -0,0 +1,119 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStore76(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		dir  string
+		keys []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Store76
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewStore76(tt.args.dir, tt.args.keys...)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("NewStore76() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestStore76_Has(t *testing.T) {
+	should := require.New(t)
+	type setup struct {
+		dir  string
+		keys []string
+	}
+	type args struct {
+		key string
+	}
+	tests := []struct {
+		name  string
+		setup setup
+		args  args
+		want  bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStore76(tt.setup.dir, tt.setup.keys...)
+			got := s.Has(tt.args.key)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Store76.Has() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestStore76_Path(t *testing.T) {
+	should := require.New(t)
+	type setup struct {
+		dir  string
+		keys []string
+	}
+	type args struct {
+		key string
+	}
+	tests := []struct {
+		name  string
+		setup setup
+		args  args
+		want  string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := *NewStore76(tt.setup.dir, tt.setup.keys...)
+			got := s.Path(tt.args.key)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Store76.Path() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestNewCounter76(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name string
+		want Counter76
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewCounter76()
+			should.Equal(got, tt.want,
+				fmt.Sprintf("NewCounter76() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestCounter76_Next(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name string
+		want Counter76
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCounter76()
+			got := c.Next()
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Counter76.Next() = %v, want %v", got, tt.want))
+		})
+	}
+}
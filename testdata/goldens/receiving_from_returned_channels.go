@@ -0,0 +1,76 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCount74(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Count74(tt.args.n)
+			select {
+			case v, ok := <-got:
+				if !ok {
+					t.Fatalf("Count74() closed without sending, want %v", tt.want)
+				}
+				should.Equal(v, tt.want,
+					fmt.Sprintf("Count74() received %v, want %v", v, tt.want))
+			case <-time.After(time.Second):
+				t.Fatalf("Count74() sent nothing after 1s, want %v", tt.want)
+			}
+		})
+	}
+}
+
+func TestStart74(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Start74(tt.args.name)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Start74() error = %v, wantErr %v", err, tt.wantErr))
+			if tt.wantErr {
+				// The values returned with an error aren't compared.
+				return
+			}
+
+			select {
+			case v, ok := <-got:
+				if !ok {
+					t.Fatalf("Start74() closed without sending, want %v", tt.want)
+				}
+				should.Equal(v, tt.want,
+					fmt.Sprintf("Start74() received %v, want %v", v, tt.want))
+			case <-time.After(time.Second):
+				t.Fatalf("Start74() sent nothing after 1s, want %v", tt.want)
+			}
+		})
+	}
+}
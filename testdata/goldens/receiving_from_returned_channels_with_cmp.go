@@ -0,0 +1,74 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCount74(t *testing.T) {
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Count74(tt.args.n)
+		select {
+		case v, ok := <-got:
+			if !ok {
+				t.Fatalf("%q. Count74() closed without sending, want %v", tt.name, tt.want)
+			}
+			if diff := cmp.Diff(tt.want, v); diff != "" {
+				t.Errorf("%q. Count74() received mismatch (-want +got):\n%s", tt.name, diff)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%q. Count74() sent nothing after 1s, want %v", tt.name, tt.want)
+		}
+	}
+}
+
+func TestStart74(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Start74(tt.args.name)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Start74() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+		if tt.wantErr {
+			// The values returned with an error aren't compared.
+			continue
+		}
+
+		select {
+		case v, ok := <-got:
+			if !ok {
+				t.Fatalf("%q. Start74() closed without sending, want %v", tt.name, tt.want)
+			}
+			if diff := cmp.Diff(tt.want, v); diff != "" {
+				t.Errorf("%q. Start74() received mismatch (-want +got):\n%s", tt.name, diff)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%q. Start74() sent nothing after 1s, want %v", tt.name, tt.want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package testdata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCount74(t *testing.T) {
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Count74(tt.args.n)
+			select {
+			case v, ok := <-got:
+				if !ok {
+					t.Fatalf("Count74() closed without sending, want %v", tt.want)
+				}
+				require.Equal(t, tt.want, v)
+			case <-time.After(time.Second):
+				t.Fatalf("Count74() sent nothing after 1s, want %v", tt.want)
+			}
+		})
+	}
+}
+
+func TestStart74(t *testing.T) {
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Start74(tt.args.name)
+
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.wantErr {
+				// The values returned with an error aren't compared.
+				return
+			}
+
+			select {
+			case v, ok := <-got:
+				if !ok {
+					t.Fatalf("Start74() closed without sending, want %v", tt.want)
+				}
+				require.Equal(t, tt.want, v)
+			case <-time.After(time.Second):
+				t.Fatalf("Start74() sent nothing after 1s, want %v", tt.want)
+			}
+		})
+	}
+}
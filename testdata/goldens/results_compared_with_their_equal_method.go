@@ -0,0 +1,88 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney82_Equal(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		Cents    int64
+		Currency string
+	}
+	type args struct {
+		o Money82
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Money82{
+				Cents:    tt.fields.Cents,
+				Currency: tt.fields.Currency,
+			}
+			got := m.Equal(tt.args.o)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Money82.Equal() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestDeadline82(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		start time.Time
+		d     time.Duration
+	}
+	tests := []struct {
+		name string
+		args args
+		want time.Time
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Deadline82(tt.args.start, tt.args.d)
+			should.True(got.Equal(tt.want),
+				fmt.Sprintf("Deadline82() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestSum82(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a Money82
+		b Money82
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Money82
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Sum82(tt.args.a, tt.args.b)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Sum82() error = %v, wantErr %v", err, tt.wantErr))
+
+			should.True(got.Equal(tt.want),
+				fmt.Sprintf("Sum82() = %v, want %v", got, tt.want))
+		})
+	}
+}
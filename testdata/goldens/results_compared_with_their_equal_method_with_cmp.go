@@ -0,0 +1,84 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney82_Equal(t *testing.T) {
+	type fields struct {
+		Cents    int64
+		Currency string
+	}
+	type args struct {
+		o Money82
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		m := Money82{
+			Cents:    tt.fields.Cents,
+			Currency: tt.fields.Currency,
+		}
+		got := m.Equal(tt.args.o)
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("%q. Money82.Equal() mismatch (-want +got):\n%s", tt.name, diff)
+		}
+	}
+}
+
+func TestDeadline82(t *testing.T) {
+	type args struct {
+		start time.Time
+		d     time.Duration
+	}
+	tests := []struct {
+		name string
+		args args
+		want time.Time
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Deadline82(tt.args.start, tt.args.d)
+		if !got.Equal(tt.want) {
+			t.Errorf("%q. Deadline82() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSum82(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a Money82
+		b Money82
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Money82
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Sum82(tt.args.a, tt.args.b)
+
+		should.Equal(err != nil, tt.wantErr,
+			fmt.Sprintf("%q. Sum82() error = %v, wantErr %v", tt.name, err, tt.wantErr))
+
+		if !got.Equal(tt.want) {
+			t.Errorf("%q. Sum82() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrain55(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		in  <-chan string
+		out chan<- string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.args.in == nil {
+				tt.args.in = make(chan string)
+			}
+			if tt.args.out == nil {
+				tt.args.out = make(chan string)
+			}
+			got := Drain55(tt.args.in, tt.args.out)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Drain55() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestRetry55(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		n  int
+		fn func(int) error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.args.fn == nil {
+				tt.args.fn = func(int) error { return nil }
+			}
+			err := Retry55(tt.args.n, tt.args.fn)
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Retry55() error = %v, wantErr %v", err, tt.wantErr))
+		})
+	}
+}
+
+func TestMap55(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		xs []int
+		f  func(int) (int, bool)
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.args.f == nil {
+				tt.args.f = func(int) (int, bool) { return 0, false }
+			}
+			got := Map55(tt.args.xs, tt.args.f)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Map55() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse99(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			wantErr: false,
+		},
+		{
+			name:    "error",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse99(tt.args.s)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Parse99() error = %v, wantErr %v", err, tt.wantErr))
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Parse99() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestValidate99(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			wantErr: false,
+		},
+		{
+			name:    "error",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate99(tt.args.s)
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Validate99() error = %v, wantErr %v", err, tt.wantErr))
+		})
+	}
+}
+
+func TestLen99(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Len99(tt.args.s)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Len99() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounter42_Next(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		Step int
+	}
+	setupTest := func(t *testing.T, f fields) *Counter42 {
+		t.Helper()
+		return &Counter42{
+			Step: f.Step,
+		}
+	}
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := setupTest(t, tt.fields)
+			got := c.Next(tt.args.n)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Counter42.Next() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestDiv42(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Div42(tt.args.a, tt.args.b)
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Div42() error = %v, wantErr %v", err, tt.wantErr))
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Div42() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestReset42(t *testing.T) {
+	type args struct {
+		c *Counter42
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Reset42(tt.args.c)
+		})
+	}
+}
+
+func Test_errString42_Error(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name string
+		e    errString42
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.e.Error()
+			should.Equal(got, tt.want,
+				fmt.Sprintf("errString42.Error() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbs87(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	t.Skip("TODO: implement")
+	for _, tt := range tests {
+		got := Abs87(tt.args.n)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Abs87() = %v, want %v", tt.name, got, tt.want))
+	}
+}
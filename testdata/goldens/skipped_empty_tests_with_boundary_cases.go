@@ -0,0 +1,48 @@
+package testdata
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbs87(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "n=0",
+			args: args{n: 0},
+		},
+		{
+			name: "n=min",
+			args: args{n: math.MinInt},
+		},
+		{
+			name: "n=max",
+			args: args{n: math.MaxInt},
+		},
+		{
+			name: "n=-1",
+			args: args{n: -1},
+		},
+		{
+			name: "n=1",
+			args: args{n: 1},
+		},
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Abs87(tt.args.n)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Abs87() = %v, want %v", tt.name, got, tt.want))
+	}
+}
@@ -0,0 +1,74 @@
+package testdata
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnregister66(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Unregister66(tt.args.name)
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Unregister66() error = %v, wantErr %v", err, tt.wantErr))
+		})
+	}
+}
+
+func TestRegistered66(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Registered66(tt.args.name)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Registered66() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestDump66(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		prefix string
+	}
+	tests := []struct {
+		name  string
+		args  args
+		wantW string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			Dump66(w, tt.args.prefix)
+			gotW := w.String()
+			should.Equal(gotW, tt.wantW,
+				fmt.Sprintf("Dump66() = %v, want %v", gotW, tt.wantW))
+		})
+	}
+}
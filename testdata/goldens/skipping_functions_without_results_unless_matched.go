@@ -0,0 +1,20 @@
+package testdata
+
+import "testing"
+
+func TestRegister66(t *testing.T) {
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Register66(tt.args.name)
+		})
+	}
+}
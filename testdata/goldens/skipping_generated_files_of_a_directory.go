@@ -0,0 +1,27 @@
+package generated
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDouble(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Double(tt.args.n)
+		should.Equal(got, tt.want,
+			fmt.Sprintf("%q. Double() = %v, want %v", tt.name, got, tt.want))
+	}
+}
@@ -0,0 +1,55 @@
+package testdata
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeys65(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		m map[string]int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Keys65(tt.args.m)
+			sort.Slice(got, func(i, j int) bool { return got[i] < got[j] })
+			sort.Slice(tt.want, func(i, j int) bool { return tt.want[i] < tt.want[j] })
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Keys65() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestPairs65(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		m map[string]int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []Pair65
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Pairs65(tt.args.m)
+			// TODO: Sort got and tt.want, whose elements aren't ordered, to
+			// compare them regardless of their order.
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Pairs65() = %v, want %v", got, tt.want))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package testdata
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestKeys65(t *testing.T) {
+	type args struct {
+		m map[string]int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Keys65(tt.args.m)
+			if diff := cmp.Diff(tt.want, got, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("Keys65() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPairs65(t *testing.T) {
+	type args struct {
+		m map[string]int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []Pair65
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Pairs65(tt.args.m)
+			// TODO: Sort got and tt.want, whose elements aren't ordered, to
+			// compare them regardless of their order.
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Pairs65() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
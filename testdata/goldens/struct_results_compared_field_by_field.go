@@ -0,0 +1,33 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMove94(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		p  Point94
+		dx int
+		dy int
+	}
+	tests := []struct {
+		name string
+		args args
+		want Point94
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Move94(tt.args.p, tt.args.dx, tt.args.dy)
+		should.Equal(got.X, tt.want.X,
+			fmt.Sprintf("%q. Move94().X = %v, want %v", tt.name, got.X, tt.want.X))
+		should.Equal(got.Y, tt.want.Y,
+			fmt.Sprintf("%q. Move94().Y = %v, want %v", tt.name, got.Y, tt.want.Y))
+		should.Equal(got.Tags, tt.want.Tags,
+			fmt.Sprintf("%q. Move94().Tags = %v, want %v", tt.name, got.Tags, tt.want.Tags))
+	}
+}
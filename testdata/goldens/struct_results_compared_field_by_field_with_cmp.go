@@ -0,0 +1,36 @@
+package testdata
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMove94(t *testing.T) {
+	type args struct {
+		p  Point94
+		dx int
+		dy int
+	}
+	tests := []struct {
+		name string
+		args args
+		want Point94
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Move94(tt.args.p, tt.args.dx, tt.args.dy)
+			if got.X != tt.want.X {
+				t.Errorf("Move94().X = %v, want %v", got.X, tt.want.X)
+			}
+			if got.Y != tt.want.Y {
+				t.Errorf("Move94().Y = %v, want %v", got.Y, tt.want.Y)
+			}
+			if diff := cmp.Diff(tt.want.Tags, got.Tags); diff != "" {
+				t.Errorf("Move94().Tags mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFib62(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Fib62(tt.args.n)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Fib62() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func BenchmarkFib62(b *testing.B) {
+	type args struct {
+		n int
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for _, size := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("n=%v", size), func(b *testing.B) {
+			tt.args.n = size
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = Fib62(tt.args.n)
+			}
+		})
+	}
+}
+
+func TestChecksum62(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint32
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Checksum62(tt.args.data)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Checksum62() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func BenchmarkChecksum62(b *testing.B) {
+	type args struct {
+		data []byte
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for _, size := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("data=%v", size), func(b *testing.B) {
+			tt.args.data = make([]byte, size)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = Checksum62(tt.args.data)
+			}
+		})
+	}
+}
+
+func TestRepeat62(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s   string
+		sep string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Repeat62(tt.args.s, tt.args.sep)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Repeat62() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func BenchmarkRepeat62(b *testing.B) {
+	type args struct {
+		s   string
+		sep string
+	}
+	tt := struct {
+		args args
+	}{
+		// TODO: Add benchmark inputs.
+	}
+	for i := 0; i < b.N; i++ {
+		_ = Repeat62(tt.args.s, tt.args.sep)
+	}
+}
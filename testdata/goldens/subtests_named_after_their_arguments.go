@@ -0,0 +1,30 @@
+package testdata
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepeat92(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+		n int
+	}
+	tests := []struct {
+		args args
+		want string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(strings.NewReplacer(" ", "_", "/", "_").Replace(fmt.Sprintf("%v", tt.args)), func(t *testing.T) {
+			got := Repeat92(tt.args.s, tt.args.n)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Repeat92() = %v, want %v", got, tt.want))
+		})
+	}
+}
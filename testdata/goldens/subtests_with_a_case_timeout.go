@@ -0,0 +1,181 @@
+package testdata
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetch67(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		url string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []byte
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+			defer cancel()
+			var got []byte
+			var err error
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				got, err = Fetch67(tt.args.url)
+			}()
+			select {
+			case <-done:
+			case <-ctx.Done():
+				t.Fatalf("Fetch67(%v) timed out after 1.5s", tt.args.url)
+			}
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Fetch67(%v) error = %v, wantErr %v", tt.args.url, err, tt.wantErr))
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Fetch67(%v) = %v, want %v", tt.args.url, got, tt.want))
+		})
+	}
+}
+
+func TestWait67(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		d time.Duration
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+			defer cancel()
+			var err error
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				err = Wait67(tt.args.d)
+			}()
+			select {
+			case <-done:
+			case <-ctx.Done():
+				t.Fatalf("Wait67(%v) timed out after 1.5s", tt.args.d)
+			}
+
+			should.Equal(err != nil, tt.wantErr,
+				fmt.Sprintf("Wait67(%v) error = %v, wantErr %v", tt.args.d, err, tt.wantErr))
+		})
+	}
+}
+
+func TestCount67(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+			defer cancel()
+			var got int
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				got = Count67(tt.args.s)
+			}()
+			select {
+			case <-done:
+			case <-ctx.Done():
+				t.Fatalf("Count67(%v) timed out after 1.5s", tt.args.s)
+			}
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Count67(%v) = %v, want %v", tt.args.s, got, tt.want))
+		})
+	}
+}
+
+func TestPing67(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+			defer cancel()
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				Ping67()
+			}()
+			select {
+			case <-done:
+			case <-ctx.Done():
+				t.Fatalf("Ping67() timed out after 1.5s")
+			}
+		})
+	}
+}
+
+func TestLookup67(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		ctx context.Context
+		key string
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  string
+		want1 bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+			defer cancel()
+			var got string
+			var got1 bool
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				got, got1 = Lookup67(tt.args.ctx, tt.args.key)
+			}()
+			select {
+			case <-done:
+			case <-ctx.Done():
+				t.Fatalf("Lookup67(%v, %v) timed out after 1.5s", tt.args.ctx, tt.args.key)
+			}
+
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Lookup67(%v, %v) got = %v, want %v", tt.args.ctx, tt.args.key, got, tt.want))
+
+			should.Equal(got1, tt.want1,
+				fmt.Sprintf("Lookup67(%v, %v) got1 = %v, want1 %v", tt.args.ctx, tt.args.key, got1, tt.want1))
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_testdata_Celsius61_Fahrenheit(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name string
+		c    Celsius61
+		want float64
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.c.Fahrenheit()
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Celsius61.Fahrenheit() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func Test_testdata_Readings_Max(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name string
+		r    Readings
+		want Celsius61
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.r.Max()
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Readings.Max() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func Test_testdata_Site_Rename(t *testing.T) {
+	type fields struct {
+		Name string
+	}
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Site{
+				Name: tt.fields.Name,
+			}
+			s.Rename(tt.args.name)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package testdata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse52(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr error
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse52(tt.args.s)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
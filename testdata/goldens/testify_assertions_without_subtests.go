@@ -0,0 +1,50 @@
+package testdata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSum52(t *testing.T) {
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got := Sum52(tt.args.a, tt.args.b)
+		require.Equal(t, tt.want, got, "%q. Sum52(%v, %v)", tt.name, tt.args.a, tt.args.b)
+	}
+}
+
+func TestParse52(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		got, err := Parse52(tt.args.s)
+
+		if tt.wantErr {
+			require.Error(t, err, "%q. Parse52(%v)", tt.name, tt.args.s)
+		} else {
+			require.NoError(t, err, "%q. Parse52(%v)", tt.name, tt.args.s)
+		}
+
+		require.Equal(t, tt.want, got, "%q. Parse52(%v)", tt.name, tt.args.s)
+	}
+}
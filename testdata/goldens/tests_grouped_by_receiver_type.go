@@ -0,0 +1,125 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Tests for functions
+
+func TestNewStack98(t *testing.T) {
+	should := require.New(t)
+	tests := []struct {
+		name string
+		want *Stack98
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewStack98()
+			should.Equal(got, tt.want,
+				fmt.Sprintf("NewStack98() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestMax98(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Max98(tt.args.a, tt.args.b)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Max98() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+// Tests for type Stack98
+
+func TestStack98_Push(t *testing.T) {
+	type fields struct {
+		items []int
+	}
+	type args struct {
+		v int
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Stack98{
+				items: tt.fields.items,
+			}
+			s.Push(tt.args.v)
+		})
+	}
+}
+
+func TestStack98_Len(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		items []int
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Stack98{
+				items: tt.fields.items,
+			}
+			got := s.Len()
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Stack98.Len() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+// Tests for type Queue98
+
+func TestQueue98_Len(t *testing.T) {
+	should := require.New(t)
+	type fields struct {
+		items []int
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   int
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &Queue98{
+				items: tt.fields.items,
+			}
+			got := q.Len()
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Queue98.Len() = %v, want %v", got, tt.want))
+		})
+	}
+}
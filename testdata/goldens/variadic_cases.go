@@ -0,0 +1,65 @@
+package testdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJoin54(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		sep   string
+		parts []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "no parts",
+		},
+		{
+			name: "two parts",
+			args: args{parts: []string{"a", "b"}},
+		},
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Join54(tt.args.sep, tt.args.parts...)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Join54() = %v, want %v", got, tt.want))
+		})
+	}
+}
+
+func TestSum54(t *testing.T) {
+	should := require.New(t)
+	type args struct {
+		nums []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "no nums",
+		},
+		{
+			name: "two nums",
+			args: args{nums: []int{1, 2}},
+		},
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Sum54(tt.args.nums...)
+			should.Equal(got, tt.want,
+				fmt.Sprintf("Sum54() = %v, want %v", got, tt.want))
+		})
+	}
+}
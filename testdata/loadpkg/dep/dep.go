@@ -0,0 +1,9 @@
+package dep
+
+// A Sink receives lines.
+type Sink interface {
+	Put(line string) error
+}
+
+// A Level is a logging level.
+type Level int
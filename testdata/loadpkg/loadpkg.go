@@ -0,0 +1,7 @@
+package loadpkg
+
+import "github.com/cweill/gotests/testdata/loadpkg/dep"
+
+func Emit(s dep.Sink, lvl dep.Level, msg string) error {
+	return s.Put(msg)
+}
@@ -0,0 +1,15 @@
+package sibling
+
+import "testing"
+
+func TestFoo(t *testing.T) {
+	if Foo("a") != 1 {
+		t.Error("Foo() != 1")
+	}
+}
+
+func BenchmarkBar(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Bar("a")
+	}
+}
@@ -0,0 +1,7 @@
+package sibling
+
+func Foo(s string) int { return len(s) }
+
+func Bar(s string) int { return len(s) }
+
+func Baz(s string) int { return len(s) }
@@ -0,0 +1,15 @@
+package testdata
+
+import "strconv"
+
+func Parse38(s string, b []byte, base int64, signed bool) (int64, error) {
+	return strconv.ParseInt(s+string(b), int(base), 64)
+}
+
+func (p *Person) Rename38(first string, age uint8) string {
+	return first
+}
+
+func Lookup38(m map[string]int, key string) int {
+	return m[key]
+}
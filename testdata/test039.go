@@ -0,0 +1,38 @@
+package testdata
+
+type Number interface {
+	~int64 | ~float64
+}
+
+func Map39[T, U any](s []T, f func(T) U) []U {
+	us := make([]U, 0, len(s))
+	for _, t := range s {
+		us = append(us, f(t))
+	}
+	return us
+}
+
+func Sum39[K comparable, V Number](m map[K]V) V {
+	var sum V
+	for _, v := range m {
+		sum += v
+	}
+	return sum
+}
+
+type Stack39[T any] struct {
+	items []T
+}
+
+func (s *Stack39[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+func (s *Stack39[E]) Pop() (E, bool) {
+	var e E
+	if len(s.items) == 0 {
+		return e, false
+	}
+	e, s.items = s.items[len(s.items)-1], s.items[:len(s.items)-1]
+	return e, true
+}
@@ -0,0 +1,9 @@
+package testdata
+
+import "strings"
+
+func Upper40(s string) string { return strings.ToUpper(s) }
+
+func Lower40(s string) string { return strings.ToLower(s) }
+
+func Trim40(s string) (string, error) { return strings.TrimSpace(s), nil }
@@ -0,0 +1,20 @@
+package testdata
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestUpper40 is hand written.
+func TestUpper40(t *testing.T) {
+	if got := Upper40("a"); got != "A" {
+		t.Errorf("Upper40() = %v, want %v", got, "A")
+	}
+}
+
+func BenchmarkLower40(b *testing.B) {
+	s := strings.Repeat("A", 64)
+	for i := 0; i < b.N; i++ {
+		Lower40(s)
+	}
+}
@@ -0,0 +1,26 @@
+package testdata
+
+import "strings"
+
+type Shape41 struct {
+	Name  string
+	sides int
+}
+
+func NewShape41(name string, sides int) *Shape41 { return &Shape41{Name: name, sides: sides} }
+
+func (s *Shape41) Describe(prefix string) string { return prefix + s.Name }
+
+type Color41 int
+
+func Mix41(a, b Color41) (Color41, error) { return a + b, nil }
+
+func Repeat41(s string, n int) string { return strings.Repeat(s, n) }
+
+func scale41(s *Shape41, n int) {}
+
+type point41 struct{ x, y int }
+
+func (p point41) Norm() int { return p.x*p.x + p.y*p.y }
+
+func Origin41(p point41) bool { return p.x == 0 && p.y == 0 }
@@ -0,0 +1,22 @@
+package testdata
+
+type Counter42 struct {
+	Step int
+}
+
+func (c *Counter42) Next(n int) int { return n + c.Step }
+
+func Div42(a, b int) (int, error) {
+	if b == 0 {
+		return 0, errDivByZero42
+	}
+	return a / b, nil
+}
+
+func Reset42(c *Counter42) { c.Step = 0 }
+
+var errDivByZero42 = errString42("division by zero")
+
+type errString42 string
+
+func (e errString42) Error() string { return string(e) }
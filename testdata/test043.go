@@ -0,0 +1,13 @@
+package testdata
+
+import "context"
+
+func Fetch43(ctx context.Context, id int) (string, error) { return "", nil }
+
+func Ping43(ctx context.Context) error { return ctx.Err() }
+
+type Client43 struct {
+	Addr string
+}
+
+func (c *Client43) Do43(ctx context.Context, req string) string { return c.Addr + req }
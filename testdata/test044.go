@@ -0,0 +1,5 @@
+package testdata
+
+import stdctx "context"
+
+func Wait44(c stdctx.Context, n int) bool { return c.Err() == nil && n > 0 }
@@ -0,0 +1,25 @@
+package testdata
+
+import (
+	"fmt"
+	"io"
+)
+
+type Store45 interface {
+	Get(key string) (string, error)
+	Put(key, value string) error
+}
+
+type Logger45 interface {
+	Logf(format string, args ...interface{})
+}
+
+func Copy45(dst io.Writer, src io.Reader) (int64, error) { return io.Copy(dst, src) }
+
+func Describe45(s fmt.Stringer, prefix string) string { return prefix + s.String() }
+
+func Log45(l Logger45) { l.Logf("%v", 45) }
+
+func Sync45(s Store45, key string) error { return s.Put(key, "") }
+
+func Wrap45(err error) error { return err }
@@ -0,0 +1,15 @@
+package testdata
+
+// Sum46 is tested.
+func Sum46(a, b int) int { return a + b }
+
+//gotests:skip
+func generated46() string { return "generated" }
+
+// Deprecated: Use Sum46.
+//
+//gotests:skip
+func Add46(a, b int) int { return Sum46(a, b) }
+
+// Double46 is tested, even though gotests:skip appears in its doc comment.
+func Double46(n int) int { return 2 * n }
@@ -0,0 +1,15 @@
+package testdata
+
+import "os"
+
+func Open47(name string) (*os.File, error) { return os.Open(name) }
+
+type Conn47 struct {
+	addr string
+}
+
+func (c *Conn47) Close() error { return nil }
+
+func Dial47(addr string) *Conn47 { return &Conn47{addr} }
+
+func Addr47(c *Conn47) string { return c.addr }
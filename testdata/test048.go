@@ -0,0 +1,17 @@
+package testdata
+
+import "time"
+
+type Point48 struct {
+	X, Y int
+}
+
+type Level48 int
+
+func Describe48(p Point48, l Level48, tags map[string]bool, next *Point48, d time.Duration, opts ...string) string {
+	return ""
+}
+
+func Grid48(size [2]int, fn func(Point48) bool, ok bool) ([]Point48, error) { return nil, nil }
+
+func Touch48(p *Point48) {}
@@ -0,0 +1,22 @@
+package testdata
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func Health49(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprint(w, "ok")
+}
+
+type Server49 struct {
+	Greeting string
+}
+
+func (s *Server49) Greet(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintf(w, "%v, %v", s.Greeting, r.URL.Query().Get("name"))
+}
+
+func Redirect49(w http.ResponseWriter, r *http.Request, target string) {
+	http.Redirect(w, r, target, http.StatusFound)
+}
@@ -0,0 +1,41 @@
+package testdata
+
+// Clamp50 returns v limited to the range [lo, hi]. If lo is greater than hi, the bounds are swapped before clamping, so that the result is always within the range they describe.
+//
+// For example:
+//
+//	Clamp50(5, 0, 3) // 3
+//	Clamp50(5, 3, 0) // 3
+func Clamp50(v, lo, hi int) int {
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+func Abs50(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+/*
+Sign50 returns -1, 0, or 1
+depending on the sign of v.
+*/
+func Sign50(v int) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,36 @@
+package testdata
+
+type Stack51 struct {
+	items []int
+}
+
+func (s *Stack51) Push(v int) {
+	s.items = append(s.items, v)
+}
+
+func NewStack51(items ...int) *Stack51 {
+	return &Stack51{items: items}
+}
+
+type Queue51 struct {
+	items []int
+}
+
+func (q *Queue51) Len() int {
+	return len(q.items)
+}
+
+func (s *Stack51) Len() int {
+	return len(s.items)
+}
+
+func (q Queue51) Peek() int {
+	return q.items[0]
+}
+
+func Max51(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
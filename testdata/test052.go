@@ -0,0 +1,17 @@
+package testdata
+
+import (
+	"errors"
+	"strconv"
+)
+
+func Sum52(a, b int) int {
+	return a + b
+}
+
+func Parse52(s string) (int, error) {
+	if s == "" {
+		return 0, errors.New("empty")
+	}
+	return strconv.Atoi(s)
+}
@@ -0,0 +1,18 @@
+package testdata
+
+type Counter53 struct {
+	n int
+}
+
+func (c *Counter53) Add(d int) int {
+	c.n += d
+	return c.n
+}
+
+func Bar53(s string) string {
+	return s + "bar"
+}
+
+func bar53(s string) string {
+	return "bar" + s
+}
@@ -0,0 +1,15 @@
+package testdata
+
+import "strings"
+
+func Join54(sep string, parts ...string) string {
+	return strings.Join(parts, sep)
+}
+
+func Sum54(nums ...int) int {
+	var s int
+	for _, n := range nums {
+		s += n
+	}
+	return s
+}
@@ -0,0 +1,30 @@
+package testdata
+
+func Drain55(in <-chan string, out chan<- string) int {
+	var n int
+	for s := range in {
+		out <- s
+		n++
+	}
+	return n
+}
+
+func Retry55(n int, fn func(int) error) error {
+	var err error
+	for i := 0; i < n; i++ {
+		if err = fn(i); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func Map55(xs []int, f func(int) (int, bool)) []int {
+	var ys []int
+	for _, x := range xs {
+		if y, ok := f(x); ok {
+			ys = append(ys, y)
+		}
+	}
+	return ys
+}
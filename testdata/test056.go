@@ -0,0 +1,18 @@
+package testdata
+
+func Parse56(s string) (n int, rest string, err error) {
+	return len(s), "", nil
+}
+
+func Cut56(s string, sep byte) (before, after string, found bool) {
+	for i := 0; i < len(s); i++ {
+		if s[i] == sep {
+			return s[:i], s[i+1:], true
+		}
+	}
+	return s, "", false
+}
+
+func Check56(s string) (ok bool, err bool, e error) {
+	return s != "", s == "", nil
+}
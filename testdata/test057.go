@@ -0,0 +1,14 @@
+package testdata
+
+func Index57(s []int, i int) int {
+	if i < 0 || i >= len(s) {
+		panic("index out of range")
+	}
+	return s[i]
+}
+
+func MustPositive57(n int) {
+	if n <= 0 {
+		panic(n)
+	}
+}
@@ -0,0 +1,14 @@
+package testdata
+
+import "errors"
+
+func Div58(a, b int) (int, error) {
+	if b == 0 {
+		return 0, errors.New("division by zero")
+	}
+	return a / b, nil
+}
+
+func Log58(msg string) {}
+
+func Reset58() {}
@@ -0,0 +1,23 @@
+package testdata
+
+import (
+	"fmt"
+	"strings"
+)
+
+func Render59(title string, items []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %v\n", title)
+	for _, it := range items {
+		fmt.Fprintf(&b, "- %v\n", it)
+	}
+	return b.String()
+}
+
+func Marshal59(v interface{}) ([]byte, error) {
+	return []byte(fmt.Sprint(v)), nil
+}
+
+func Count59(s string) int {
+	return len(s)
+}
@@ -0,0 +1,9 @@
+package testdata
+
+type Point60 struct {
+	X, Y int
+}
+
+func Translate60(p Point60, dx, dy int) Point60 {
+	return Point60{X: p.X + dx, Y: p.Y + dy}
+}
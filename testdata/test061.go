@@ -0,0 +1,57 @@
+package testdata
+
+type Celsius61 float64
+
+func (c Celsius61) Fahrenheit() float64 {
+	return float64(c)*9/5 + 32
+}
+
+func (c Celsius61) Plus(d float64) Celsius61 {
+	return c + Celsius61(d)
+}
+
+func (c *Celsius61) Warm(d float64) {
+	*c += Celsius61(d)
+}
+
+type Readings []Celsius61
+
+func (r Readings) Max() Celsius61 {
+	var m Celsius61
+	for _, c := range r {
+		if c > m {
+			m = c
+		}
+	}
+	return m
+}
+
+func (r *Readings) Add(c Celsius61) {
+	*r = append(*r, c)
+}
+
+type Station struct {
+	Name string
+}
+
+type Site = Station
+
+func (s *Site) Rename(name string) {
+	s.Name = name
+}
+
+func (r Readings) Above(min float64) int {
+	n := 0
+	for _, c := range r {
+		if float64(c) > min {
+			n++
+		}
+	}
+	return n
+}
+
+type Degrees = Celsius61
+
+func (d Degrees) Kelvin() float64 {
+	return float64(d) + 273.15
+}
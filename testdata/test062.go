@@ -0,0 +1,20 @@
+package testdata
+
+func Fib62(n int) int {
+	if n < 2 {
+		return n
+	}
+	return Fib62(n-1) + Fib62(n-2)
+}
+
+func Checksum62(data []byte) uint32 {
+	var sum uint32
+	for _, b := range data {
+		sum = sum*31 + uint32(b)
+	}
+	return sum
+}
+
+func Repeat62(s string, sep string) string {
+	return s + sep + s
+}
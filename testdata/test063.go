@@ -0,0 +1,35 @@
+package testdata
+
+import "io"
+
+type options63 struct {
+	verbose bool
+}
+
+func DefaultOptions63() *options63 {
+	return &options63{}
+}
+
+func Run63(w io.Writer, name string, opts *options63) (int, error) {
+	return 0, nil
+}
+
+type level63 int
+
+func Log63(l level63, msg string) {}
+
+func Open63(path string) *options63 {
+	return nil
+}
+
+type Logger63 struct {
+	Prefix string
+	opts   *options63
+	Level  level63
+}
+
+func (l *Logger63) Print(opts *options63, args ...string) {}
+
+func Count63(items ...string) int {
+	return len(items)
+}
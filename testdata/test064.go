@@ -0,0 +1,22 @@
+package testdata
+
+import (
+	"errors"
+	"os"
+)
+
+func Address64() (string, error) {
+	host := os.Getenv("APP_HOST")
+	port, ok := os.LookupEnv("APP_PORT")
+	if !ok {
+		return "", errors.New("APP_PORT is not set")
+	}
+	if host == "" {
+		host = os.Getenv("APP_HOST")
+	}
+	return host + ":" + port, nil
+}
+
+func Join64(a, b string) string {
+	return a + b
+}
@@ -0,0 +1,22 @@
+package testdata
+
+type Pair65 struct {
+	Key   string
+	Value int
+}
+
+func Keys65(m map[string]int) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func Pairs65(m map[string]int) []Pair65 {
+	var ps []Pair65
+	for k, v := range m {
+		ps = append(ps, Pair65{k, v})
+	}
+	return ps
+}
@@ -0,0 +1,31 @@
+package testdata
+
+import (
+	"errors"
+	"io"
+	"strings"
+)
+
+var registry66 = map[string]bool{}
+
+func Register66(name string) {
+	registry66[name] = true
+}
+
+func Unregister66(name string) error {
+	if !registry66[name] {
+		return errors.New("not registered")
+	}
+	delete(registry66, name)
+	return nil
+}
+
+func Registered66(name string) bool {
+	return registry66[name]
+}
+
+func Dump66(w io.Writer, prefix string) {
+	for name := range registry66 {
+		io.WriteString(w, prefix+strings.ToUpper(name)+"\n")
+	}
+}
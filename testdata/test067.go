@@ -0,0 +1,31 @@
+package testdata
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+func Fetch67(url string) ([]byte, error) {
+	if url == "" {
+		return nil, errors.New("empty url")
+	}
+	return []byte(url), nil
+}
+
+func Wait67(d time.Duration) error {
+	time.Sleep(d)
+	return nil
+}
+
+func Count67(s string) int {
+	return strings.Count(s, " ") + 1
+}
+
+func Ping67() {}
+
+func Lookup67(ctx context.Context, key string) (string, bool) {
+	v, ok := ctx.Value(key).(string)
+	return v, ok
+}
@@ -0,0 +1,20 @@
+package testdata
+
+import (
+	"strconv"
+	"strings"
+)
+
+var resets68 int
+
+func Join68(sep string, parts ...string) string {
+	return strings.Join(parts, sep)
+}
+
+func Parse68(s string) (int, error) {
+	return strconv.Atoi(s)
+}
+
+func Reset68() {
+	resets68++
+}
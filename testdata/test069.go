@@ -0,0 +1,44 @@
+package testdata
+
+import (
+	"errors"
+	"strings"
+)
+
+type Point69 struct {
+	X, Y int
+}
+
+func NewPoint69(x, y int) *Point69 {
+	return &Point69{X: x, Y: y}
+}
+
+func Bounds69(ps []Point69) (*struct{ Min, Max Point69 }, error) {
+	if len(ps) == 0 {
+		return nil, errors.New("no points")
+	}
+	b := &struct{ Min, Max Point69 }{ps[0], ps[0]}
+	for _, p := range ps[1:] {
+		if p.X < b.Min.X {
+			b.Min.X = p.X
+		}
+		if p.Y < b.Min.Y {
+			b.Min.Y = p.Y
+		}
+		if p.X > b.Max.X {
+			b.Max.X = p.X
+		}
+		if p.Y > b.Max.Y {
+			b.Max.Y = p.Y
+		}
+	}
+	return b, nil
+}
+
+func Cut69(s, sep string) (*string, *string) {
+	before, after, found := strings.Cut(s, sep)
+	if !found {
+		return &before, nil
+	}
+	return &before, &after
+}
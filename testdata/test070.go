@@ -0,0 +1,27 @@
+package testdata
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+func Greet70(name string) {
+	fmt.Printf("Hello, %v!\n", name)
+}
+
+func Sum70(ns ...int) (int, error) {
+	if len(ns) == 0 {
+		return 0, errors.New("no numbers")
+	}
+	var sum int
+	for _, n := range ns {
+		fmt.Fprintln(os.Stdout, n)
+		sum += n
+	}
+	return sum, nil
+}
+
+func Double70(n int) int {
+	return 2 * n
+}
@@ -0,0 +1,11 @@
+package testdata
+
+import "strings"
+
+func Initials71(name string) string {
+	var b strings.Builder
+	for _, word := range strings.Fields(name) {
+		b.WriteString(word[:1])
+	}
+	return strings.ToUpper(b.String())
+}
@@ -0,0 +1,19 @@
+package testdata
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+func Split72(s string) (int, string, error) {
+	i := strings.IndexByte(s, ':')
+	if i < 0 {
+		return 0, "", errors.New("missing colon")
+	}
+	n, err := strconv.Atoi(s[:i])
+	if err != nil {
+		return 0, "", err
+	}
+	return n, s[i+1:], nil
+}
@@ -0,0 +1,19 @@
+package testdata
+
+// Min73 returns the smaller of a and b.
+func Min73(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+var zero73 int
+
+// Max73 returns the larger of a and b.
+func Max73(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
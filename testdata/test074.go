@@ -0,0 +1,21 @@
+package testdata
+
+import "errors"
+
+func Count74(n int) <-chan int {
+	ch := make(chan int, n)
+	for i := 0; i < n; i++ {
+		ch <- i
+	}
+	close(ch)
+	return ch
+}
+
+func Start74(name string) (<-chan string, error) {
+	if name == "" {
+		return nil, errors.New("no name")
+	}
+	ch := make(chan string, 1)
+	go func() { ch <- "started " + name }()
+	return ch, nil
+}
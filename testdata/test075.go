@@ -0,0 +1,9 @@
+package testdata
+
+func Add75(a, b int) int {
+	return a + b
+}
+
+func Scale75(x float64, n uint8) float64 {
+	return x * float64(n)
+}
@@ -0,0 +1,34 @@
+package testdata
+
+import "path/filepath"
+
+type Store76 struct {
+	dir  string
+	keys map[string]bool
+}
+
+func NewStore76(dir string, keys ...string) *Store76 {
+	s := &Store76{dir: dir, keys: map[string]bool{}}
+	for _, k := range keys {
+		s.keys[k] = true
+	}
+	return s
+}
+
+func (s *Store76) Has(key string) bool {
+	return s.keys[key]
+}
+
+func (s Store76) Path(key string) string {
+	return filepath.Join(s.dir, key)
+}
+
+type Counter76 int
+
+func NewCounter76() Counter76 {
+	return 1
+}
+
+func (c Counter76) Next() Counter76 {
+	return c + 1
+}
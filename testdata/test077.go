@@ -0,0 +1,10 @@
+package testdata
+
+func Lookup77(m map[string]int, key string) (value int, ok bool) {
+	value, ok = m[key]
+	return value, ok
+}
+
+func Valid77(s string) bool {
+	return s != ""
+}
@@ -0,0 +1,13 @@
+package testdata
+
+func Sum78(nums []int) int {
+	results := make(chan int)
+	for _, n := range nums {
+		go func(n int) { results <- n }(n)
+	}
+	var sum int
+	for range nums {
+		sum += <-results
+	}
+	return sum
+}
@@ -0,0 +1,26 @@
+package testdata
+
+import "time"
+
+type Server79 struct {
+	addr    string
+	timeout time.Duration
+}
+
+type ServerOption func(*Server79)
+
+func WithAddr79(addr string) ServerOption {
+	return func(s *Server79) { s.addr = addr }
+}
+
+func WithTimeout79(d time.Duration) ServerOption {
+	return func(s *Server79) { s.timeout = d }
+}
+
+func New79(opts ...ServerOption) *Server79 {
+	s := &Server79{addr: ":8080", timeout: time.Second}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
@@ -0,0 +1,12 @@
+package testdata
+
+type Box81 struct {
+	X, Y, Z int
+	Volume  int
+	Empty   bool
+}
+
+func NewBox81(x, y, z int) Box81 {
+	v := x * y * z
+	return Box81{X: x, Y: y, Z: z, Volume: v, Empty: v == 0}
+}
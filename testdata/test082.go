@@ -0,0 +1,20 @@
+package testdata
+
+import "time"
+
+type Money82 struct {
+	Cents    int64
+	Currency string
+}
+
+func (m Money82) Equal(o Money82) bool {
+	return m.Cents == o.Cents && m.Currency == o.Currency
+}
+
+func Deadline82(start time.Time, d time.Duration) time.Time {
+	return start.Add(d)
+}
+
+func Sum82(a, b Money82) (Money82, error) {
+	return Money82{Cents: a.Cents + b.Cents, Currency: a.Currency}, nil
+}
@@ -0,0 +1,24 @@
+package testdata
+
+import (
+	"errors"
+	"strconv"
+)
+
+func Parse83(s string, base int) (int, error) {
+	if s == "" {
+		return 0, errors.New("empty")
+	}
+	switch base {
+	case 2, 8:
+		s = "0" + s
+	case 16:
+		s = "0x" + s
+	default:
+	}
+	n, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
@@ -0,0 +1,11 @@
+package testdata
+
+func Clamp84(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
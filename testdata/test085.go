@@ -0,0 +1,7 @@
+package testdata
+
+import "strings"
+
+func Title85(s string) string {
+	return strings.Title(s)
+}
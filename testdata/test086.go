@@ -0,0 +1,23 @@
+package testdata
+
+import "iter"
+
+func Evens86(n int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; i < n; i += 2 {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func Enumerate86(s []string) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		for i, v := range s {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
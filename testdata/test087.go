@@ -0,0 +1,8 @@
+package testdata
+
+func Abs87(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,7 @@
+package testdata
+
+import "fmt"
+
+func Format88(a, b int, c, d string) string {
+	return fmt.Sprintf("%d%s%d%s", a, c, b, d)
+}
@@ -0,0 +1,11 @@
+package testdata
+
+import "sync/atomic"
+
+type Counter89 struct {
+	n int64
+}
+
+func (c *Counter89) Add(n int64) int64 {
+	return atomic.AddInt64(&c.n, n)
+}
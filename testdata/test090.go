@@ -0,0 +1,19 @@
+package testdata
+
+import "strconv"
+
+func Parse90(s string) (int, error) { return strconv.Atoi(s) }
+
+type Reader90 struct{ buf []byte }
+
+func (r *Reader90) Len() int { return len(r.buf) }
+
+func (r *Reader90) Reset() []byte {
+	b := r.buf
+	r.buf = nil
+	return b
+}
+
+type Writer90 struct{ buf []byte }
+
+func (w *Writer90) Len() int { return len(w.buf) }
@@ -0,0 +1,7 @@
+package testdata
+
+import "strings"
+
+func Repeat92(s string, n int) string {
+	return strings.Repeat(s, n)
+}
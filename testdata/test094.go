@@ -0,0 +1,10 @@
+package testdata
+
+type Point94 struct {
+	X, Y int
+	Tags []string
+}
+
+func Move94(p Point94, dx, dy int) Point94 {
+	return Point94{X: p.X + dx, Y: p.Y + dy, Tags: p.Tags}
+}
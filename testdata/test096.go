@@ -0,0 +1,27 @@
+package testdata
+
+import "os"
+
+func List96(dir string) ([]string, error) {
+	es, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range es {
+		names = append(names, e.Name())
+	}
+	return names, nil
+}
+
+func Save96(path string, data []byte) error {
+	return os.WriteFile(path, data, 0644)
+}
+
+func Size96(f *os.File) (int64, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	return fi.Size(), nil
+}
@@ -0,0 +1,20 @@
+package testdata
+
+type Stack98 struct{ items []int }
+
+func (s *Stack98) Push(v int) { s.items = append(s.items, v) }
+
+func NewStack98() *Stack98 { return &Stack98{} }
+
+func (s *Stack98) Len() int { return len(s.items) }
+
+type Queue98 struct{ items []int }
+
+func (q *Queue98) Len() int { return len(q.items) }
+
+func Max98(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
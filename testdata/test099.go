@@ -0,0 +1,17 @@
+package testdata
+
+import (
+	"errors"
+	"strconv"
+)
+
+func Parse99(s string) (int, error) { return strconv.Atoi(s) }
+
+func Validate99(s string) error {
+	if s == "" {
+		return errors.New("empty")
+	}
+	return nil
+}
+
+func Len99(s string) int { return len(s) }